@@ -13,4 +13,7 @@ const (
 	Description       commons.FieldName = "description"
 	Zones             commons.FieldName = "zones"
 	PreferredZones    commons.FieldName = "preferred_zones"
+
+	WaitForCapacity        commons.FieldName = "wait_for_capacity"
+	WaitForCapacityTimeout commons.FieldName = "wait_for_capacity_timeout"
 )
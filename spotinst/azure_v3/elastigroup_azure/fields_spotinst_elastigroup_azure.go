@@ -1,6 +1,7 @@
 package elastigroup_azure
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -405,6 +406,28 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[WaitForCapacity] = commons.NewGenericField(
+		commons.ElastigroupAzure,
+		WaitForCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCapacityTimeout] = commons.NewGenericField(
+		commons.ElastigroupAzure,
+		WaitForCapacityTimeout,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCapacity].AddDiffValidator(validateWaitForCapacity)
 }
 
 func expandZones(data interface{}) ([]string, error) {
@@ -419,3 +442,11 @@ func expandZones(data interface{}) ([]string, error) {
 
 	return result, nil
 }
+
+func validateWaitForCapacity(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	if err := commons.ValidateDiffRequiredTogether(resourceDiff,
+		string(WaitForCapacity), string(WaitForCapacityTimeout)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffLessOrEqual(resourceDiff, string(WaitForCapacity), string(DesiredCapacity))
+}
@@ -26,8 +26,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(Password): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
 					},
 
 					string(SSHPublicKey): {
@@ -43,7 +44,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var value []interface{} = nil
 			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
 				elastigroup.Compute.LaunchSpecification.Login != nil {
-				value = flattenAzureGroupLogin(elastigroup.Compute.LaunchSpecification.Login)
+				value = commons.KeepSensitiveValues(resourceData, string(Login),
+					flattenAzureGroupLogin(elastigroup.Compute.LaunchSpecification.Login), Password)
 			}
 			if err := resourceData.Set(string(Login), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Login), err)
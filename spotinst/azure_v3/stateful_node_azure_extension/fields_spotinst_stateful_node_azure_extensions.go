@@ -46,9 +46,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(ProtectedSettings): {
-						Type:     schema.TypeMap,
-						Optional: true,
-						Computed: true,
+						Type:      schema.TypeMap,
+						Optional:  true,
+						Sensitive: true,
+						Computed:  true,
 					},
 
 					string(PublicSettings): {
@@ -25,8 +25,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 					string(Password): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
 					},
 					string(SSHPublicKey): {
 						Type:     schema.TypeString,
@@ -40,7 +41,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			statefulNode := snWrapper.GetStatefulNode()
 			var result []interface{} = nil
 			if statefulNode.Compute != nil && statefulNode.Compute.LaunchSpecification != nil && statefulNode.Compute.LaunchSpecification.Login != nil {
-				result = flattenLogin(statefulNode.Compute.LaunchSpecification.Login)
+				result = commons.KeepSensitiveValues(resourceData, string(Login),
+					flattenLogin(statefulNode.Compute.LaunchSpecification.Login), Password)
 			}
 			if result != nil {
 				if err := resourceData.Set(string(Login), result); err != nil {
@@ -0,0 +1,143 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// ResourceAccountID is the argument overriding the provider account of a
+// resource.
+const ResourceAccountID = "account_id"
+
+// ForAccount returns a client bound to the given account, or the client itself
+// when the account is empty or is the account of the client. Clients of other
+// accounts share the configuration and credentials of the client, and are
+// cached for the lifetime of the provider.
+func (c *Client) ForAccount(account string) (*Client, error) {
+	if account == "" || account == c.config.Account {
+		return c, nil
+	}
+
+	c.accountsMu.Lock()
+	defer c.accountsMu.Unlock()
+
+	if client, ok := c.accounts[account]; ok {
+		return client, nil
+	}
+
+	config := c.config
+	config.Account = account
+	client, diags := config.Client()
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to configure client of account %s: %v", account, diags)
+	}
+
+	if c.accounts == nil {
+		c.accounts = make(map[string]*Client)
+	}
+	c.accounts[account] = client
+	return client, nil
+}
+
+// withAccountOverride adds an optional account_id argument to the resource,
+// and binds the API calls of the resource to that account instead of the
+// provider one, when it is set. Imported resources may carry the account as
+// an ID of the form `<account_id>:<id>`.
+func withAccountOverride(res *schema.Resource) *schema.Resource {
+	if _, ok := res.Schema[ResourceAccountID]; ok {
+		return res
+	}
+
+	res.Schema[ResourceAccountID] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Spotinst account ID of the resource, overriding the provider account",
+	}
+
+	// The types of the state upgraders are built from the prior schemas of the
+	// resource, which predate the argument added above. Legacy (flatmap) states
+	// are decoded against these types, and would lose the account otherwise.
+	for i, upgrader := range res.StateUpgraders {
+		res.StateUpgraders[i].Type = withAccountAttribute(upgrader.Type)
+	}
+
+	res.CreateContext = withAccountContext(res.CreateContext)
+	res.ReadContext = withAccountContext(res.ReadContext)
+	if res.UpdateContext != nil {
+		res.UpdateContext = withAccountContext(res.UpdateContext)
+	}
+	res.DeleteContext = withAccountContext(res.DeleteContext)
+
+	if res.Importer != nil && res.Importer.StateContext != nil {
+		importState := res.Importer.StateContext
+		res.Importer.StateContext = func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if account, id, ok := parseAccountImportID(resourceData.Id()); ok {
+				resourceData.SetId(id)
+				if err := resourceData.Set(ResourceAccountID, account); err != nil {
+					return nil, fmt.Errorf(string(commons.FailureFieldReadPattern), ResourceAccountID, err)
+				}
+			}
+
+			client, err := accountClient(resourceData, meta)
+			if err != nil {
+				return nil, err
+			}
+			return importState(ctx, resourceData, client)
+		}
+	}
+
+	return res
+}
+
+type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func withAccountContext(fn contextFunc) contextFunc {
+	return func(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, err := accountClient(resourceData, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return fn(ctx, resourceData, client)
+	}
+}
+
+// accountClient returns the client of the account the resource belongs to.
+func accountClient(resourceData *schema.ResourceData, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*Client)
+	if !ok {
+		return meta, nil
+	}
+	account, _ := resourceData.Get(ResourceAccountID).(string)
+	return client.ForAccount(account)
+}
+
+// parseAccountImportID splits an import ID of the form `<account_id>:<id>`.
+func parseAccountImportID(importID string) (account, id string, ok bool) {
+	parts := strings.SplitN(importID, ":", 2)
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "act-") || parts[1] == "" {
+		return "", importID, false
+	}
+	return parts[0], parts[1], true
+}
+
+// withAccountAttribute returns the given object type of a resource state with
+// the account_id attribute added.
+func withAccountAttribute(t cty.Type) cty.Type {
+	if !t.IsObjectType() || t.HasAttribute(ResourceAccountID) {
+		return t
+	}
+
+	attrs := make(map[string]cty.Type, len(t.AttributeTypes())+1)
+	for name, attr := range t.AttributeTypes() {
+		attrs[name] = attr
+	}
+	attrs[ResourceAccountID] = cty.String
+	return cty.Object(attrs)
+}
@@ -0,0 +1,122 @@
+package spotinst
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestClientForAccount(t *testing.T) {
+	client := newFakeAPI(t).Client()
+
+	if c, err := client.ForAccount(""); err != nil || c != client {
+		t.Errorf("empty account: got %p, %v, want the client itself", c, err)
+	}
+	if c, err := client.ForAccount(client.config.Account); err != nil || c != client {
+		t.Errorf("provider account: got %p, %v, want the client itself", c, err)
+	}
+
+	other, err := client.ForAccount("act-87654321")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == client {
+		t.Fatalf("expected a client of another account")
+	}
+	if other.config.Token != client.config.Token {
+		t.Errorf("expected the client of another account to share the token")
+	}
+	if cached, _ := client.ForAccount("act-87654321"); cached != other {
+		t.Errorf("expected the client of another account to be cached")
+	}
+}
+
+func TestResourceAccountOverride(t *testing.T) {
+	api := newFakeAPI(t)
+	res := Provider().ResourcesMap["spotinst_elastigroup_aws"]
+
+	config := roundTripCases["spotinst_elastigroup_aws"].generateConfig(res.Schema, "")
+	config[ResourceAccountID] = "act-87654321"
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+
+	if diags := res.CreateContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+
+	requests := api.Requests()
+	if len(requests) == 0 {
+		t.Fatalf("no requests were sent")
+	}
+	for _, req := range requests {
+		if got := req.Query.Get("accountId"); got != "act-87654321" {
+			t.Errorf("%s %s: got account %q, want %q", req.Method, req.Path, got, "act-87654321")
+		}
+	}
+}
+
+func TestResourceAccountOverride_StateUpgrade(t *testing.T) {
+	p := Provider()
+	server := schema.NewGRPCProviderServer(p)
+
+	for _, name := range []string{"spotinst_elastigroup_aws", "spotinst_ocean_aws"} {
+		t.Run(name, func(t *testing.T) {
+			res := p.ResourcesMap[name]
+			for _, upgrader := range res.StateUpgraders {
+				if !upgrader.Type.HasAttribute(ResourceAccountID) {
+					t.Errorf("upgrader of version %d: missing %s", upgrader.Version, ResourceAccountID)
+				}
+			}
+
+			resp, err := server.UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+				TypeName: name,
+				Version:  0,
+				RawState: &tfprotov5.RawState{Flatmap: map[string]string{
+					"id":              "res-12345678",
+					"name":            "name",
+					"user_data":       "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+					ResourceAccountID: "act-87654321",
+				}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("upgrade failed: %s: %s", d.Summary, d.Detail)
+			}
+
+			state, err := msgpack.Unmarshal(resp.UpgradedState.MsgPack, res.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := state.GetAttr(ResourceAccountID); got.IsNull() || got.AsString() != "act-87654321" {
+				t.Errorf("%s: got %#v, want %q", ResourceAccountID, got, "act-87654321")
+			}
+			if got := state.GetAttr("user_data").AsString(); got != "" {
+				t.Errorf("user_data: got %q, want the empty SHA1 sum to be dropped", got)
+			}
+		})
+	}
+}
+
+func TestParseAccountImportID(t *testing.T) {
+	cases := map[string]struct {
+		account, id string
+		ok          bool
+	}{
+		"act-12345678:sig-12345678": {"act-12345678", "sig-12345678", true},
+		"sig-12345678":              {"", "sig-12345678", false},
+		"act-12345678:":             {"", "act-12345678:", false},
+		"name:with-colon":           {"", "name:with-colon", false},
+	}
+
+	for importID, want := range cases {
+		account, id, ok := parseAccountImportID(importID)
+		if account != want.account || id != want.id || ok != want.ok {
+			t.Errorf("%s: got (%q, %q, %v), want (%q, %q, %v)",
+				importID, account, id, ok, want.account, want.id, want.ok)
+		}
+	}
+}
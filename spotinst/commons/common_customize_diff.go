@@ -0,0 +1,113 @@
+package commons
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DiffHasChange reports whether any of the given attributes is changed by the
+// plan. Diff validators use it to only check the values that are modified, so
+// that unrelated changes are never blocked by values already in the state.
+func DiffHasChange(resourceDiff *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if resourceDiff.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDiffInt returns the planned value of an integer attribute, and whether it
+// is set, including to zero, to a value that is known at plan time.
+func GetDiffInt(resourceDiff *schema.ResourceDiff, key string) (int, bool) {
+	if !resourceDiff.NewValueKnown(key) {
+		return 0, false
+	}
+	if v, ok := resourceDiff.GetOkExists(key); ok {
+		if i, ok := v.(int); ok {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// ValidateDiffLessOrEqual validates that the planned value of the lower
+// attribute does not exceed the planned value of the upper attribute.
+func ValidateDiffLessOrEqual(resourceDiff *schema.ResourceDiff, lowerKey, upperKey string) error {
+	if !DiffHasChange(resourceDiff, lowerKey, upperKey) {
+		return nil
+	}
+
+	lower, hasLower := GetDiffInt(resourceDiff, lowerKey)
+	upper, hasUpper := GetDiffInt(resourceDiff, upperKey)
+	if hasLower && hasUpper && lower > upper {
+		return fmt.Errorf("%q (%d) must be less than or equal to %q (%d)", lowerKey, lower, upperKey, upper)
+	}
+	return nil
+}
+
+// ValidateDiffCapacity validates the ordering of the minimum, desired and
+// maximum capacity of a resource.
+func ValidateDiffCapacity(resourceDiff *schema.ResourceDiff, minKey, desiredKey, maxKey FieldName) error {
+	pairs := [][2]FieldName{
+		{minKey, maxKey},
+		{minKey, desiredKey},
+		{desiredKey, maxKey},
+	}
+	for _, pair := range pairs {
+		if err := ValidateDiffLessOrEqual(resourceDiff, string(pair[0]), string(pair[1])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateDiffPercentage validates that the planned value of a percentage
+// attribute is between 0 and 100.
+func ValidateDiffPercentage(resourceDiff *schema.ResourceDiff, key string) error {
+	if !resourceDiff.HasChange(key) || !resourceDiff.NewValueKnown(key) {
+		return nil
+	}
+
+	var value float64
+	switch v := resourceDiff.Get(key).(type) {
+	case int:
+		value = float64(v)
+	case float64:
+		value = v
+	default:
+		return nil
+	}
+	if value < 0 || value > 100 {
+		return fmt.Errorf("%q must be between 0 and 100, got: %v", key, value)
+	}
+	return nil
+}
+
+// ValidateDiffRequiredTogether validates that the given attributes are either
+// all set or all unset.
+func ValidateDiffRequiredTogether(resourceDiff *schema.ResourceDiff, keys ...string) error {
+	if !DiffHasChange(resourceDiff, keys...) {
+		return nil
+	}
+
+	var set, unset []string
+	for _, key := range keys {
+		if !resourceDiff.NewValueKnown(key) {
+			return nil
+		}
+		if _, ok := resourceDiff.GetOk(key); ok {
+			set = append(set, strconv.Quote(key))
+		} else {
+			unset = append(unset, strconv.Quote(key))
+		}
+	}
+	if len(set) > 0 && len(unset) > 0 {
+		return fmt.Errorf("%s must be set together with %s",
+			strings.Join(unset, ", "), strings.Join(set, ", "))
+	}
+	return nil
+}
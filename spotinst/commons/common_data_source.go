@@ -0,0 +1,123 @@
+package commons
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	DataSourceID        FieldName = "id"
+	DataSourceName      FieldName = "name"
+	DataSourceTagFilter FieldName = "tag_filter"
+	DataSourceTagKey    FieldName = "key"
+	DataSourceTagValue  FieldName = "value"
+)
+
+// DataSourceSchemaFromResourceSchema converts a resource schema into a data
+// source schema by marking every attribute (including nested ones) as computed.
+// The returned map is a deep copy, the resource schema is left untouched.
+func DataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		ds[k] = dataSourceSchemaFromSchema(v)
+	}
+	return ds
+}
+
+func dataSourceSchemaFromSchema(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Type:        rs.Type,
+		Description: rs.Description,
+		Computed:    true,
+		Sensitive:   rs.Sensitive,
+		Set:         rs.Set,
+	}
+
+	switch elem := rs.Elem.(type) {
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{
+			Schema: DataSourceSchemaFromResourceSchema(elem.Schema),
+		}
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{
+			Type: elem.Type,
+		}
+	}
+
+	return ds
+}
+
+// AddDataSourceLookupFields marks the given attributes of a data source schema
+// as optional so they can be used as lookup arguments.
+func AddDataSourceLookupFields(ds map[string]*schema.Schema, keys ...FieldName) {
+	for _, k := range keys {
+		if v, ok := ds[string(k)]; ok {
+			v.Optional = true
+		}
+	}
+}
+
+// DataSourceTagFilterSchema returns the schema of the `tag_filter` block shared
+// by the data sources that support looking up objects by their tags.
+func DataSourceTagFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				string(DataSourceTagKey): {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				string(DataSourceTagValue): {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// ExpandDataSourceTagFilter returns the tag filters configured on a data source
+// as a key/value map.
+func ExpandDataSourceTagFilter(resourceData *schema.ResourceData) map[string]string {
+	filters := make(map[string]string)
+	if v, ok := resourceData.GetOk(string(DataSourceTagFilter)); ok {
+		for _, item := range v.([]interface{}) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			filters[m[string(DataSourceTagKey)].(string)] = m[string(DataSourceTagValue)].(string)
+		}
+	}
+	return filters
+}
+
+// MatchDataSourceTags reports whether all the given filters are satisfied by
+// the tags of an object.
+func MatchDataSourceTags(filters map[string]string, tags map[string]string) bool {
+	for k, v := range filters {
+		if tv, ok := tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// SelectDataSourceMatch returns the single ID out of the IDs of the objects
+// matching the lookup arguments of a data source, and fails when none or
+// several objects matched. The kind names the objects in plural form.
+func SelectDataSourceMatch(kind string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s matched the given criteria", kind)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("multiple %s matched the given criteria: %v, "+
+			"please use more specific search criteria", kind, ids)
+	}
+}
@@ -0,0 +1,59 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+)
+
+const (
+	ElastigroupAWSInstanceOperationResourceName ResourceName = "spotinst_elastigroup_aws_instance_operation"
+)
+
+var ElastigroupAWSInstanceOperationResource *ElastigroupAWSInstanceOperationTerraformResource
+
+type ElastigroupAWSInstanceOperationTerraformResource struct {
+	GenericResource
+}
+
+// ElastigroupAWSInstanceOperationWrapper holds the request of the operation,
+// either a detach of instances or a scale of the group.
+type ElastigroupAWSInstanceOperationWrapper struct {
+	GroupID *string
+	Detach  *aws.DetachGroupInput
+	Scale   *aws.ScaleGroupInput
+}
+
+func NewElastigroupAWSInstanceOperationResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSInstanceOperationTerraformResource {
+	return &ElastigroupAWSInstanceOperationTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSInstanceOperationResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns the
+// operation to perform or an error.
+func (res *ElastigroupAWSInstanceOperationTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*ElastigroupAWSInstanceOperationWrapper, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	operationWrapper := &ElastigroupAWSInstanceOperationWrapper{}
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(operationWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return operationWrapper, nil
+}
@@ -78,7 +78,7 @@ func (res *OceanAKSNPTerraformResource) OnRead(
 
 func (res *OceanAKSNPTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, bool, *azure_np.Cluster, error) {
+	meta interface{}, excludeParam []interface{}) (bool, bool, *azure_np.Cluster, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
 		return false, false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
@@ -93,7 +93,7 @@ func (res *OceanAKSNPTerraformResource) OnUpdate(
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
-			if contains(conditionedRollFieldsAKS, field.fieldNameStr) {
+			if field.requiresConditionedRoll(nil, excludeParam) {
 				changesRequiredRoll = true
 			}
 
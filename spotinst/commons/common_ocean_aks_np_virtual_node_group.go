@@ -78,7 +78,7 @@ func (res *OceanAKSNPVirtualNodeGroupTerraformResource) OnRead(
 
 func (res *OceanAKSNPVirtualNodeGroupTerraformResource) OnUpdate(
 	resourceData *schema.ResourceData,
-	meta interface{}) (bool, bool, *azure_np.VirtualNodeGroup, error) {
+	meta interface{}, conditionParam, excludeParam []interface{}) (bool, bool, *azure_np.VirtualNodeGroup, error) {
 
 	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
 		return false, false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
@@ -93,7 +93,7 @@ func (res *OceanAKSNPVirtualNodeGroupTerraformResource) OnUpdate(
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
-			if contains(conditionedRollFieldsAKS, field.fieldNameStr) {
+			if field.requiresConditionedRoll(conditionParam, excludeParam) {
 				changesRequiredRoll = true
 			}
 
@@ -92,20 +92,12 @@ func (res *OceanAWSTerraformResource) OnUpdate(
 	changesRequiredRoll := false
 	tagsChanged := false
 
-	if len(conditionParam) > 0 {
-		conditionedRollParams := make([]string, len(conditionParam))
-		for i, v := range conditionParam {
-			conditionedRollParams[i] = fmt.Sprint(v)
-		}
-		conditionedRollFieldsAWS = conditionedRollParams
-	}
-
 	for _, field := range res.fields.fieldsMap {
 		if field.onUpdate == nil {
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
-			if contains(conditionedRollFieldsAWS, field.fieldNameStr) {
+			if field.requiresConditionedRollOverride(conditionParam) {
 				changesRequiredRoll = true
 			}
 
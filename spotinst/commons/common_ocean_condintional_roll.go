@@ -1,16 +1,91 @@
 package commons
 
-var conditionedRollFieldsAWS = []string{"subnet_ids", "whitelist", "blacklist", "user_data", "image_id", "security_groups",
-	"key_name", "iam_instance_profile", "associate_public_ip_address", "load_balancers", "instance_metadata_options",
-	"ebs_optimized", "root_volume_size"}
+import (
+	"context"
+	"fmt"
+	"sort"
 
-var conditionedRollFieldsECS = []string{"subnet_ids", "whitelist", "blacklist", "user_data", "image_id", "security_groups",
-	"key_pair", "iam_instance_profile", "associate_public_ip_address", "block_device_mappings", "optimize_images",
-	"instance_metadata_options"}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
-var conditionedRollFieldsGKE = []string{"backend_services", "root_volume_type", "whitelist"}
+// MarkRequiresRoll declares that changing the field requires replacing the
+// nodes, so that the change triggers a conditioned roll of the resource.
+func (field *GenericField) MarkRequiresRoll() *GenericField {
+	field.requiresRoll = true
+	return field
+}
+
+// requiresConditionedRoll reports whether a change of the field triggers a
+// conditioned roll: either it requires replacing the nodes, or it is listed in
+// `conditioned_roll_params`, which extends the fields requiring a roll rather
+// than replacing them. Fields listed in `conditioned_roll_exclude_params`
+// never trigger the roll.
+func (field *GenericField) requiresConditionedRoll(conditionParam, excludeParam []interface{}) bool {
+	if containsParam(excludeParam, field.fieldNameStr) {
+		return false
+	}
+	return field.requiresRoll || containsParam(conditionParam, field.fieldNameStr)
+}
+
+// requiresConditionedRollOverride reports whether a change of the field
+// triggers a conditioned roll, for the resources whose
+// `conditioned_roll_params` replace the fields requiring a roll when set.
+func (field *GenericField) requiresConditionedRollOverride(conditionParam []interface{}) bool {
+	if len(conditionParam) > 0 {
+		return containsParam(conditionParam, field.fieldNameStr)
+	}
+	return field.requiresRoll
+}
+
+// ConditionedRollFields returns the sorted names of the resource fields whose
+// change requires replacing the nodes.
+func (res *GenericResource) ConditionedRollFields() []string {
+	var out []string
+	if res.fields != nil {
+		for _, field := range res.fields.fieldsMap {
+			if field.requiresRoll {
+				out = append(out, field.fieldNameStr)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
 
-var conditionedRollFieldsAKS = []string{"availability_zones", "max_pods_per_node", "enable_node_public_ip", "os_disk_size_gb", "os_disk_type", "os_sku", "kubernetes_version", "vnet_subnet_ids", "pod_subnet_ids", "labels", "taints", "tags"}
+// ValidateConditionedRollParams returns a diff validator which verifies that
+// every value of the `conditioned_roll_params` or
+// `conditioned_roll_exclude_params` list at key names a field of the
+// resource. The fields map is read when the validator runs, so that it sees
+// the fields added by every package of the resource.
+func ValidateConditionedRollParams(fieldsMap map[FieldName]*GenericField, key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+		params, ok := resourceDiff.GetOk(key)
+		if !ok {
+			return nil
+		}
+
+		for _, v := range params.([]interface{}) {
+			param, _ := v.(string)
+			if param == "" { // unknown until apply
+				continue
+			}
+			if _, ok := fieldsMap[FieldName(param)]; !ok {
+				return fmt.Errorf("%s: %q is not an attribute of this resource", key, param)
+			}
+		}
+		return nil
+	}
+}
+
+func containsParam(params []interface{}, str string) bool {
+	for _, v := range params {
+		if fmt.Sprint(v) == str {
+			return true
+		}
+	}
+
+	return false
+}
 
 func contains(s []string, str string) bool {
 	for _, v := range s {
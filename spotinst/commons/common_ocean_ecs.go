@@ -96,7 +96,7 @@ func (res *OceanECSTerraformResource) OnUpdate(
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
-			if contains(conditionedRollFieldsECS, field.fieldNameStr) {
+			if field.requiresRoll {
 				changesRequiredRoll = true
 			}
 
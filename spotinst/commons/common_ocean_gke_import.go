@@ -102,7 +102,7 @@ func (res *OceanGKEImportTerraformResource) OnUpdate(
 			continue
 		}
 		if field.hasFieldChange(resourceData, meta) {
-			if contains(conditionedRollFieldsGKE, field.fieldNameStr) {
+			if field.requiresRoll {
 				changesRequiredRoll = true
 			}
 			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
@@ -0,0 +1,122 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	OrgUserGroupMembershipResourceName              ResourceName = "spotinst_organization_user_group_membership"
+	OrgUserPolicyAttachmentResourceName             ResourceName = "spotinst_organization_user_policy_attachment"
+	OrgProgrammaticUserPolicyAttachmentResourceName ResourceName = "spotinst_organization_programmatic_user_policy_attachment"
+	OrgUserGroupPolicyAttachmentResourceName        ResourceName = "spotinst_organization_user_group_policy_attachment"
+)
+
+var (
+	OrgUserGroupMembershipResource              *OrgAttachmentTerraformResource
+	OrgUserPolicyAttachmentResource             *OrgAttachmentTerraformResource
+	OrgProgrammaticUserPolicyAttachmentResource *OrgAttachmentTerraformResource
+	OrgUserGroupPolicyAttachmentResource        *OrgAttachmentTerraformResource
+)
+
+// OrgAttachmentTerraformResource is shared by the resources managing a single
+// mapping between organization objects, such as the membership of a user in a
+// user group, or a policy attached to a user.
+type OrgAttachmentTerraformResource struct {
+	GenericResource
+}
+
+// OrgAttachment is a single mapping, held by the owner object (e.g. the user
+// group) and referencing the target object (e.g. the user).
+type OrgAttachment struct {
+	OwnerID  *string
+	TargetID *string
+
+	// AccountIDs are the accounts a policy is enforced in, when the target
+	// is a policy.
+	AccountIDs []string
+}
+
+func NewOrgAttachmentResource(resourceName ResourceName, fieldsMap map[FieldName]*GenericField) *OrgAttachmentTerraformResource {
+	return &OrgAttachmentTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: resourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns the
+// mapping to attach or an error.
+func (res *OrgAttachmentTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*OrgAttachment, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	attachment := &OrgAttachment{}
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(attachment, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return attachment, nil
+}
+
+// OnRead is called when reading an existing resource and throws an error if
+// it is unable to do so.
+func (res *OrgAttachmentTerraformResource) OnRead(
+	attachment *OrgAttachment,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(attachment, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnUpdate is called when updating an existing resource and returns whether
+// the mapping should be updated, and the updated mapping, or an error.
+func (res *OrgAttachmentTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *OrgAttachment, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	attachment := &OrgAttachment{}
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(attachment, resourceData, meta); err != nil {
+				return false, nil, err
+			}
+			hasChanged = true
+		}
+	}
+	return hasChanged, attachment, nil
+}
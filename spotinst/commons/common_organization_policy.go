@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	OrgPolicyResourceName ResourceName = "spotinst_organization_policy"
+	OrgPolicyResourceName           ResourceName = "spotinst_organization_policy"
+	OrgPolicyDocumentDataSourceName ResourceName = "spotinst_organization_policy_document"
 )
 
 var OrgPolicyResource *OrgPolicyTerraformResource
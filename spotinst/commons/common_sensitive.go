@@ -0,0 +1,167 @@
+package commons
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RedactedValue replaces the values of sensitive attributes in logs.
+const RedactedValue = "<sensitive>"
+
+// sensitiveKeys holds the API names of the sensitive attributes of every
+// resource, as registered from their schemas.
+var sensitiveKeys = struct {
+	sync.RWMutex
+	keys    map[string]bool
+	pattern *regexp.Regexp
+}{keys: make(map[string]bool)}
+
+var authorizationHeaderPattern = regexp.MustCompile(`(?im)^(Authorization:\s*\S+\s+)\S+`)
+
+// registerSensitiveFields records the attributes of a schema marked as
+// sensitive, including the nested ones, so that they are redacted from logs.
+// Attributes are sent to the API using the camel case form of their names.
+func registerSensitiveFields(schemaMap map[string]*schema.Schema) {
+	for name, s := range schemaMap {
+		if s == nil {
+			continue
+		}
+		if s.Sensitive {
+			addSensitiveKey(toCamelCase(name))
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			registerSensitiveFields(elem.Schema)
+		}
+	}
+}
+
+func addSensitiveKey(key string) {
+	sensitiveKeys.Lock()
+	defer sensitiveKeys.Unlock()
+
+	if sensitiveKeys.keys[key] {
+		return
+	}
+	sensitiveKeys.keys[key] = true
+
+	keys := make([]string, 0, len(sensitiveKeys.keys))
+	for k := range sensitiveKeys.keys {
+		keys = append(keys, regexp.QuoteMeta(k))
+	}
+	sort.Strings(keys)
+	sensitiveKeys.pattern = regexp.MustCompile(`("(?:` + strings.Join(keys, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+}
+
+func isSensitiveKey(key string) bool {
+	sensitiveKeys.RLock()
+	defer sensitiveKeys.RUnlock()
+	return sensitiveKeys.keys[key]
+}
+
+func toCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// redactJSON replaces the values of sensitive keys in a decoded JSON value.
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if isSensitiveKey(key) && elem != nil {
+				v[key] = RedactedValue
+			} else {
+				v[key] = redactJSON(elem)
+			}
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = redactJSON(elem)
+		}
+	}
+	return value
+}
+
+// RedactSensitive replaces the values of sensitive keys, and the credentials
+// of Authorization headers, in a log message such as the dump of an API
+// request or response.
+func RedactSensitive(message string) string {
+	message = authorizationHeaderPattern.ReplaceAllString(message, "${1}"+RedactedValue)
+
+	sensitiveKeys.RLock()
+	pattern := sensitiveKeys.pattern
+	sensitiveKeys.RUnlock()
+
+	if pattern == nil {
+		return message
+	}
+	return pattern.ReplaceAllString(message, `$1"`+RedactedValue+`"`)
+}
+
+// IsMaskedValue reports whether a secret read back from the API is masked or
+// omitted, rather than holding the actual value.
+func IsMaskedValue(value string) bool {
+	return value == "" || strings.Contains(value, "***")
+}
+
+// SensitiveString returns a secret read back from the API, or its value in
+// the state when the API masks it, so that masked values do not cause diffs.
+func SensitiveString(resourceData *schema.ResourceData, key string, value *string) *string {
+	if value != nil && !IsMaskedValue(*value) {
+		return value
+	}
+	if v, ok := resourceData.Get(key).(string); ok && v != "" {
+		return &v
+	}
+	return value
+}
+
+// KeepSensitiveValues replaces the masked secrets of a block read back from
+// the API with their values in the state, so that masked values do not cause
+// diffs. Only blocks holding a single element are supported.
+func KeepSensitiveValues(resourceData *schema.ResourceData, key string, block []interface{}, fields ...FieldName) []interface{} {
+	if len(block) != 1 {
+		return block
+	}
+	m, ok := block[0].(map[string]interface{})
+	if !ok {
+		return block
+	}
+
+	for _, field := range fields {
+		if v, _ := m[string(field)].(string); !IsMaskedValue(v) {
+			continue
+		}
+		if v, ok := resourceData.Get(key + ".0." + string(field)).(string); ok && v != "" {
+			m[string(field)] = v
+		}
+	}
+	return block
+}
+
+func toRedactedJson(object interface{}) ([]byte, error) {
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(redactJSON(value), "", "  ")
+}
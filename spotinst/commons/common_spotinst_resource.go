@@ -1,11 +1,14 @@
 package commons
 
 import (
+	"context"
 	"crypto/rand"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -62,6 +65,9 @@ type GenericField struct {
 	onCreate         onFieldCreate
 	onUpdate         onFieldUpdate
 	hasChangeCustom  hasFieldChange
+	diffValidators   []schema.CustomizeDiffFunc
+	stateUpgraders   []*FieldStateUpgrader
+	requiresRoll     bool
 }
 
 type GenericFields struct {
@@ -97,6 +103,7 @@ func NewGenericFields(fieldsMap map[FieldName]*GenericField) *GenericFields {
 	for _, field := range fieldsMap {
 		schemaMap[field.fieldNameStr] = field.schema
 	}
+	registerSensitiveFields(schemaMap)
 
 	return &GenericFields{
 		fieldsMap: fieldsMap,
@@ -115,6 +122,14 @@ func (field *GenericField) hasFieldChange(resourceData *schema.ResourceData, met
 	return resourceData.HasChange(field.fieldNameStr)
 }
 
+// AddDiffValidator registers a validator that is run against the planned state
+// of the resource, so that invalid configurations (typically constraints that
+// span more than one field) are reported by `terraform plan`.
+func (field *GenericField) AddDiffValidator(validator schema.CustomizeDiffFunc) *GenericField {
+	field.diffValidators = append(field.diffValidators, validator)
+	return field
+}
+
 func (res *GenericResource) GetField(fieldName FieldName) *GenericField {
 	if res.fields != nil && res.fields.fieldsMap != nil {
 		return res.fields.fieldsMap[fieldName]
@@ -130,14 +145,64 @@ func (res *GenericResource) GetSchemaMap() map[string]*schema.Schema {
 	return res.fields.schemaMap
 }
 
+// CustomizeDiff runs the diff validators registered by the resource fields and
+// reports all of their errors at once.
+func (res *GenericResource) CustomizeDiff(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	var errs []error
+	for _, field := range res.sortedFields() {
+		for _, validator := range field.diffValidators {
+			log.Printf(string(ResourceFieldOnCustomizeDiff), field.resourceAffinity, field.fieldNameStr)
+			if err := validator(ctx, resourceDiff, meta); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (res *GenericResource) sortedFields() []*GenericField {
+	if res.fields == nil || res.fields.fieldsMap == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(res.fields.fieldsMap))
+	for name := range res.fields.fieldsMap {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	fields := make([]*GenericField, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, res.fields.fieldsMap[FieldName(name)])
+	}
+	return fields
+}
+
 func (res *GenericResource) GetName() string {
 	return string(res.resourceName)
 }
 
+// ToJson returns the indented JSON form of an object, used to log the
+// configuration sent to the API. The values of sensitive attributes are
+// redacted.
 func ToJson(object interface{}) (string, error) {
-	if bytes, err := json.MarshalIndent(object, "", "  "); err != nil {
+	if bytes, err := toRedactedJson(object); err != nil {
 		return "", err
 	} else {
 		return string(bytes), nil
 	}
 }
+
+// DefaultTimeout is the default duration of a create, update or delete
+// operation, including any retries and waits it performs.
+const DefaultTimeout = 20 * time.Minute
+
+// DefaultResourceTimeouts returns the default timeouts of the create, update
+// and delete operations, which may be overridden using a `timeouts` block.
+func DefaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(DefaultTimeout),
+		Update: schema.DefaultTimeout(DefaultTimeout),
+		Delete: schema.DefaultTimeout(DefaultTimeout),
+	}
+}
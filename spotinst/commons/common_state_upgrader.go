@@ -0,0 +1,174 @@
+package commons
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FieldStateUpgrader migrates the state of a single field from a prior schema
+// version of its resource to the next one.
+type FieldStateUpgrader struct {
+	// Version is the schema version the state is migrated from.
+	Version int
+
+	// PriorFieldName is the name of the field in the prior schema version,
+	// when the field has been renamed. Defaults to the current field name.
+	PriorFieldName FieldName
+
+	// PriorSchema is the schema of the field in the prior schema version.
+	// Defaults to the current schema of the field.
+	PriorSchema *schema.Schema
+
+	// Upgrade migrates the raw state of the resource.
+	Upgrade schema.StateUpgradeFunc
+}
+
+// AddStateUpgrader registers a migration of the field state from a prior
+// schema version. Registering a migration bumps the schema version of every
+// resource using the field.
+func (field *GenericField) AddStateUpgrader(upgrader *FieldStateUpgrader) *GenericField {
+	field.stateUpgraders = append(field.stateUpgraders, upgrader)
+	return field
+}
+
+// SchemaVersion returns the current schema version of the resource, which is
+// the version following the latest one migrated by any of its fields.
+func (res *GenericResource) SchemaVersion() int {
+	version := 0
+	for _, field := range res.sortedFields() {
+		for _, upgrader := range field.stateUpgraders {
+			if upgrader.Version+1 > version {
+				version = upgrader.Version + 1
+			}
+		}
+	}
+	return version
+}
+
+// StateUpgraders returns a state upgrader for every prior schema version of
+// the resource, each running the migrations registered by the fields for
+// that version.
+func (res *GenericResource) StateUpgraders() []schema.StateUpgrader {
+	version := res.SchemaVersion()
+	if version == 0 {
+		return nil
+	}
+
+	upgraders := make([]schema.StateUpgrader, 0, version)
+	for v := 0; v < version; v++ {
+		upgraders = append(upgraders, schema.StateUpgrader{
+			Version: v,
+			Type:    res.priorSchema(v).CoreConfigSchema().ImpliedType(),
+			Upgrade: res.upgradeState(v),
+		})
+	}
+	return upgraders
+}
+
+// priorSchema returns the schema of the resource at the given prior version.
+func (res *GenericResource) priorSchema(version int) *schema.Resource {
+	schemaMap := make(map[string]*schema.Schema, len(res.GetSchemaMap()))
+	for name, s := range res.GetSchemaMap() {
+		schemaMap[name] = s
+	}
+
+	for _, field := range res.sortedFields() {
+		var prior *FieldStateUpgrader
+		for _, upgrader := range field.stateUpgraders {
+			if upgrader.Version >= version && (prior == nil || upgrader.Version < prior.Version) {
+				prior = upgrader
+			}
+		}
+		if prior == nil {
+			continue
+		}
+
+		name, s := field.fieldNameStr, field.schema
+		if prior.PriorFieldName != "" {
+			name = string(prior.PriorFieldName)
+		}
+		if prior.PriorSchema != nil {
+			s = prior.PriorSchema
+		}
+
+		delete(schemaMap, field.fieldNameStr)
+		schemaMap[name] = s
+	}
+
+	return &schema.Resource{Schema: schemaMap}
+}
+
+func (res *GenericResource) upgradeState(version int) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return nil, nil
+		}
+
+		for _, field := range res.sortedFields() {
+			for _, upgrader := range field.stateUpgraders {
+				if upgrader.Version != version {
+					continue
+				}
+				log.Printf(string(ResourceFieldOnStateUpgrade), field.resourceAffinity, field.fieldNameStr, version)
+
+				var err error
+				if rawState, err = upgrader.Upgrade(ctx, rawState, meta); err != nil {
+					return nil, fmt.Errorf("failed upgrading field %v from schema version %d: %v",
+						field.fieldNameStr, version, err)
+				}
+			}
+		}
+		return rawState, nil
+	}
+}
+
+// RenameFieldState returns a state migration that moves the value of a renamed
+// field to its new name.
+func RenameFieldState(from, to FieldName) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if v, ok := rawState[string(from)]; ok {
+			if _, exists := rawState[string(to)]; !exists {
+				rawState[string(to)] = v
+			}
+			delete(rawState, string(from))
+		}
+		return rawState, nil
+	}
+}
+
+// NormalizeFieldState returns a state migration that replaces the value of a
+// field with its normalized form.
+func NormalizeFieldState(field FieldName, normalize func(interface{}) interface{}) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if v, ok := rawState[string(field)]; ok {
+			rawState[string(field)] = normalize(v)
+		}
+		return rawState, nil
+	}
+}
+
+// ScalarToBlockFieldState returns a state migration that wraps the value of a
+// field, which used to be a scalar, in a single block under the given key.
+func ScalarToBlockFieldState(field, key FieldName) schema.StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		v, ok := rawState[string(field)]
+		if !ok {
+			return rawState, nil
+		}
+
+		switch v.(type) {
+		case nil:
+			rawState[string(field)] = []interface{}{}
+		case []interface{}, map[string]interface{}:
+			// Already a block.
+		default:
+			rawState[string(field)] = []interface{}{
+				map[string]interface{}{string(key): v},
+			}
+		}
+		return rawState, nil
+	}
+}
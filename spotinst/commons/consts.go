@@ -18,6 +18,14 @@ const (
 	ProviderAccount      FieldName = "account"
 	ProviderFeatureFlags FieldName = "feature_flags"
 
+	ProviderBaseURL        FieldName = "base_url"
+	ProviderProxyURL       FieldName = "proxy_url"
+	ProviderCABundle       FieldName = "ca_bundle"
+	ProviderRequestTimeout FieldName = "request_timeout"
+
+	ProviderMaxRetries      FieldName = "max_retries"
+	ProviderMaxRetryBackoff FieldName = "max_retry_backoff"
+
 	Subscription                         ResourceAffinity = "Subscription"
 	ElastigroupAWSBeanstalk              ResourceAffinity = "ElastigroupAWSBeanstalk"
 	ElastigroupAWSBeanstalkScheduledTask ResourceAffinity = "ElastigroupAWSBeanstalk_Scheduled_Task"
@@ -43,11 +51,13 @@ const (
 	OceanGKEImportLaunchSpecification ResourceAffinity = "Ocean_GKE_Import_Launch_Specification"
 	OceanGKEImportStrategy            ResourceAffinity = "Ocean_GKE_Import_Strategy"
 
-	OceanGKEInstanceTypes      ResourceAffinity = "Ocean_GKE_Instance_Types"
-	OceanGKEAutoScaling        ResourceAffinity = "Ocean_GKE_Auto_Scaling"
-	OceanGKEStrategy           ResourceAffinity = "Ocean_GKE_Strategy"
-	OceanGKELaunchSpec         ResourceAffinity = "Ocean_GKE_Launch_Spec"
-	OceanGKELaunchSpecStrategy ResourceAffinity = "Ocean_GKE_Launch_Spec_Strategy"
+	OceanGKEInstanceTypes       ResourceAffinity = "Ocean_GKE_Instance_Types"
+	OceanGKEScheduling          ResourceAffinity = "Ocean_GKE_Scheduling"
+	OceanGKELaunchConfiguration ResourceAffinity = "Ocean_GKE_Launch_Configuration"
+	OceanGKEAutoScaling         ResourceAffinity = "Ocean_GKE_Auto_Scaling"
+	OceanGKEStrategy            ResourceAffinity = "Ocean_GKE_Strategy"
+	OceanGKELaunchSpec          ResourceAffinity = "Ocean_GKE_Launch_Spec"
+	OceanGKELaunchSpecStrategy  ResourceAffinity = "Ocean_GKE_Launch_Spec_Strategy"
 
 	OceanGKELaunchSpecImport ResourceAffinity = "Ocean_GKE_Launch_Spec_Import"
 	OceanGKENetworkInterface ResourceAffinity = "Ocean_GKE_Network_Interface"
@@ -90,6 +100,7 @@ const (
 	OrganizationPolicy           ResourceAffinity = "Organization_Policy"
 	OrganizationProgrammaticUser ResourceAffinity = "Organization_Progammatic_User"
 	OrganizationUserGroup        ResourceAffinity = "Organization_User_Group"
+	OrganizationAttachment       ResourceAffinity = "Organization_Attachment"
 
 	ElastigroupGCP                    ResourceAffinity = "Elastigroup_GCP"
 	ElastigroupGCPDisk                ResourceAffinity = "Elastigroup_GCP_Disk"
@@ -104,6 +115,9 @@ const (
 
 	ElastigroupGKE ResourceAffinity = "Elastigroup_GKE"
 
+	ElastigroupUpdatePolicy ResourceAffinity = "Elastigroup_Update_Policy"
+	ElastigroupStateful     ResourceAffinity = "Elastigroup_Stateful"
+
 	ElastigroupAzure                    ResourceAffinity = "Elastigroup_Azure"
 	ElastigroupAzureStrategy            ResourceAffinity = "Elastigroup_Azure_Strategy"
 	ElastigroupAzureLogin               ResourceAffinity = "Elastigroup_Azure_Login"
@@ -135,6 +149,8 @@ const (
 
 	SuspendProcesses ResourceAffinity = "Suspend_Processes"
 
+	ElastigroupAWSInstanceOperation ResourceAffinity = "Elastigroup_AWS_Instance_Operation"
+
 	StatefulNodeAzure                    ResourceAffinity = "Stateful_Node_Azure"
 	StatefulNodeAzureStrategy            ResourceAffinity = "Stateful_Node_Azure_Strategy"
 	StatefulNodeAzureNetwork             ResourceAffinity = "Stateful_Node_Azure_Network"
@@ -204,8 +220,13 @@ const (
 	ResourceFieldOnUpdate LogFormat = "onUpdate() -> %s -> %s"
 	ResourceFieldOnMerge  LogFormat = "onMerge() -> %s -> %s"
 
+	ResourceFieldOnCustomizeDiff LogFormat = "onCustomizeDiff() -> %s -> %s"
+	ResourceFieldOnStateUpgrade  LogFormat = "onStateUpgrade() -> %s -> %s -> from version %d"
+
 	ResourceOnDelete LogFormat = "onDelete() -> %s -> started for %s..."
 	ResourceOnUpdate LogFormat = "onUpdate() -> %s -> started for %s..."
 	ResourceOnRead   LogFormat = "onRead() -> %s -> started for %s..."
 	ResourceOnCreate LogFormat = "onCreate() -> %s -> started..."
+
+	DataSourceOnRead LogFormat = "onRead() -> %s -> data source lookup started..."
 )
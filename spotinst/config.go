@@ -1,10 +1,17 @@
 package spotinst
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	stdlog "log"
+	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
 
@@ -27,6 +34,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst/featureflag"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 	"github.com/spotinst/terraform-provider-spotinst/version"
 )
 
@@ -35,12 +43,63 @@ var ErrNoValidCredentials = errors.New("\n\nNo valid credentials found " +
 	"providers/spotinst/index.html\nfor more information on providing " +
 	"credentials for Spotinst Provider.")
 
+const (
+	// EnvBaseURL is the environment variable the address of the Spotinst API
+	// is sourced from.
+	EnvBaseURL = "SPOTINST_BASE_URL"
+
+	// EnvProxyURL is the environment variable the address of the proxy is
+	// sourced from. The standard HTTPS_PROXY and NO_PROXY environment
+	// variables are honored when it is unset.
+	EnvProxyURL = "SPOTINST_PROXY_URL"
+
+	// EnvCABundle is the environment variable the path of the bundle of
+	// certificate authorities is sourced from.
+	EnvCABundle = "SPOTINST_CA_BUNDLE"
+
+	// EnvRequestTimeout is the environment variable the timeout of a single
+	// request is sourced from.
+	EnvRequestTimeout = "SPOTINST_REQUEST_TIMEOUT"
+
+	// EnvMaxRetries is the environment variable the maximum number of retries
+	// of a request is sourced from.
+	EnvMaxRetries = "SPOTINST_MAX_RETRIES"
+
+	// EnvMaxRetryBackoff is the environment variable the maximum delay
+	// between two attempts of a request is sourced from.
+	EnvMaxRetryBackoff = "SPOTINST_MAX_RETRY_BACKOFF"
+)
+
 type Config struct {
 	Enabled      bool
 	Token        string
 	Account      string
 	FeatureFlags string
 
+	// BaseURL overrides the address of the Spotinst API, e.g. to point the
+	// client at a regional endpoint, an API gateway or a local stand-in of
+	// the API.
+	BaseURL string
+
+	// ProxyURL is the address of the proxy requests are sent through.
+	ProxyURL string
+
+	// CABundle is the path of a PEM-encoded bundle of certificate
+	// authorities trusted in addition to the system ones.
+	CABundle string
+
+	// RequestTimeout limits the duration of a single request, including its
+	// retries. Zero means no timeout.
+	RequestTimeout time.Duration
+
+	// MaxRetries is the maximum number of times a request that is throttled
+	// or fails with a transient error is retried. Zero disables retries.
+	MaxRetries int
+
+	// MaxRetryBackoff bounds the delay between two attempts of a request.
+	// Defaults to DefaultMaxRetryBackoff.
+	MaxRetryBackoff time.Duration
+
 	terraformVersion string
 }
 
@@ -56,6 +115,12 @@ type Client struct {
 	organization    organization.Service
 	account         account.Service
 	oceancd         oceancd.Service
+
+	// config is the configuration of the client, with the credentials it
+	// resolved, used to configure the clients of other accounts.
+	config     Config
+	accountsMu sync.Mutex
+	accounts   map[string]*Client
 }
 
 // Client configures and returns a fully initialized Spotinst client.
@@ -81,6 +146,16 @@ func (c *Config) Client() (*Client, diag.Diagnostics) {
 		organization:    organization.New(sess),
 		account:         account.New(sess),
 		oceancd:         oceancd.New(sess),
+		config:          *c,
+	}
+
+	// Resolve the credentials, which may be sourced from the environment or
+	// a file, for the clients of other accounts to share them.
+	if creds, err := sess.Config.Credentials.Get(); err == nil {
+		client.config.Token = creds.Token
+		if client.config.Account == "" {
+			client.config.Account = creds.Account
+		}
 	}
 
 	stdlog.Println("[INFO] Spotinst client configured")
@@ -92,8 +167,20 @@ func (c *Config) getSession() (*session.Session, error) {
 
 	// HTTP options.
 	{
-		config.WithHTTPClient(cleanhttp.DefaultPooledClient())
+		httpClient, err := c.getHTTPClient()
+		if err != nil {
+			return nil, err
+		}
+		config.WithHTTPClient(httpClient)
 		config.WithUserAgent(c.getUserAgent())
+
+		if c.BaseURL != "" {
+			baseURL, err := url.Parse(c.BaseURL)
+			if err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+				return nil, fmt.Errorf("invalid base URL %q: must be an absolute URL", c.BaseURL)
+			}
+			config.BaseURL = baseURL
+		}
 	}
 
 	// Credentials.
@@ -108,13 +195,56 @@ func (c *Config) getSession() (*session.Session, error) {
 	// Logging.
 	{
 		config.WithLogger(log.LoggerFunc(func(format string, args ...interface{}) {
-			stdlog.Printf(fmt.Sprintf("[DEBUG] [spotinst-sdk-go] %s", format), args...)
+			// Requests and responses are dumped along with their credentials
+			// and secrets, so these are redacted.
+			stdlog.Printf("[DEBUG] [spotinst-sdk-go] %s", commons.RedactSensitive(fmt.Sprintf(format, args...)))
 		}))
 	}
 
 	return session.New(config), nil
 }
 
+func (c *Config) getHTTPClient() (*http.Client, error) {
+	httpClient := cleanhttp.DefaultPooledClient()
+	httpClient.Timeout = c.RequestTimeout
+
+	transport := httpClient.Transport.(*http.Transport)
+
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: must be an absolute URL", c.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if c.CABundle != "" {
+		pem, err := ioutil.ReadFile(c.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %v", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to read CA bundle %q: no PEM-encoded certificates found", c.CABundle)
+		}
+
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.MaxRetries > 0 {
+		httpClient.Transport = newRetryTransport(transport, c.MaxRetries, c.MaxRetryBackoff)
+	}
+
+	return httpClient, nil
+}
+
 func (c *Config) getUserAgent() string {
 	agents := []struct {
 		Product string
@@ -0,0 +1,87 @@
+package spotinst
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConfigHTTPClient_CABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(bundle, cert, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	untrusted, err := (&Config{}).getHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := untrusted.Get(server.URL); err == nil {
+		t.Fatalf("expected the server certificate not to be trusted without the CA bundle")
+	}
+
+	trusted, err := (&Config{CABundle: bundle}).getHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := trusted.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the server certificate to be trusted: %v", err)
+	}
+	resp.Body.Close()
+
+	if _, err := (&Config{CABundle: filepath.Join(t.TempDir(), "missing.pem")}).getHTTPClient(); err == nil {
+		t.Fatalf("expected an error for a missing CA bundle")
+	}
+}
+
+func TestConfigHTTPClient_ProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	client, err := (&Config{ProxyURL: proxy.URL}).getHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get("http://api.spotinst.invalid/setup/account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if want := "http://api.spotinst.invalid/setup/account"; proxied != want {
+		t.Errorf("proxied request: got %q, want %q", proxied, want)
+	}
+
+	if _, err := (&Config{ProxyURL: "proxy:3128"}).getHTTPClient(); err == nil {
+		t.Fatalf("expected an error for a proxy URL without a scheme")
+	}
+}
+
+func TestConfigHTTPClient_RequestTimeout(t *testing.T) {
+	client, err := (&Config{RequestTimeout: 30 * time.Second}).getHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Timeout != 30*time.Second {
+		t.Errorf("timeout: got %v, want %v", client.Timeout, 30*time.Second)
+	}
+}
+
+func TestConfigBaseURL_Invalid(t *testing.T) {
+	config := Config{Token: "fake-token", BaseURL: "api.spotinst.io"}
+	if _, diags := config.Client(); !diags.HasError() {
+		t.Fatalf("expected an error for a base URL without a scheme")
+	}
+}
@@ -115,17 +115,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.CredentialsGCP,
 		PrivateKeyId,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+			ForceNew:  true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			credentialsWrapper := resourceObject.(*commons.GCPCredentialsWrapper)
 			credentials := credentialsWrapper.GetCredentials()
-			var value *string = nil
-			if credentials.PrivateKeyId != nil {
-				value = credentials.PrivateKeyId
-			}
+			value := commons.SensitiveString(resourceData, string(PrivateKeyId), credentials.PrivateKeyId)
 			if err := resourceData.Set(string(PrivateKeyId), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PrivateKeyId), err)
 			}
@@ -149,17 +147,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.CredentialsGCP,
 		PrivateKey,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+			ForceNew:  true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			credentialsWrapper := resourceObject.(*commons.GCPCredentialsWrapper)
 			credentials := credentialsWrapper.GetCredentials()
-			var value *string = nil
-			if credentials.PrivateKey != nil {
-				value = credentials.PrivateKey
-			}
+			value := commons.SensitiveString(resourceData, string(PrivateKey), credentials.PrivateKey)
 			if err := resourceData.Set(string(PrivateKey), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PrivateKey), err)
 			}
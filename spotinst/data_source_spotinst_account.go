@@ -0,0 +1,124 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/common"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstAccount() *schema.Resource {
+	if commons.AccountResource == nil {
+		setupAccountResource()
+	}
+
+	s := commons.DataSourceSchemaFromResourceSchema(commons.AccountResource.GetSchemaMap())
+	commons.AddDataSourceLookupFields(s, commons.DataSourceName)
+
+	s[string(commons.DataSourceID)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstAccountRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstAccountRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead),
+		commons.AccountResource.GetName())
+
+	account, err := findAccount(ctx, resourceData, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(account.ID))
+
+	if err := commons.AccountResource.OnRead(account, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Account data source read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func findAccount(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*common.Account, error) {
+	id := resourceData.Get(string(commons.DataSourceID)).(string)
+	name := resourceData.Get(string(commons.DataSourceName)).(string)
+	if id == "" && name == "" {
+		return nil, fmt.Errorf("one of %q or %q must be set",
+			commons.DataSourceID, commons.DataSourceName)
+	}
+
+	accounts, err := listAccounts(ctx, spotinstClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %v", err)
+	}
+
+	byID := make(map[string]*common.Account)
+	var ids []string
+	for _, account := range accounts {
+		if id != "" && spotinst.StringValue(account.ID) != id {
+			continue
+		}
+		if name != "" && spotinst.StringValue(account.Name) != name {
+			continue
+		}
+		byID[spotinst.StringValue(account.ID)] = account
+		ids = append(ids, spotinst.StringValue(account.ID))
+	}
+
+	match, err := commons.SelectDataSourceMatch("accounts", ids)
+	if err != nil {
+		return nil, err
+	}
+	return byID[match], nil
+}
+
+// listAccounts lists the accounts of the organization directly using the
+// underlying API client, as the SDK does not expose it.
+func listAccounts(ctx context.Context, spotinstClient *Client) ([]*common.Account, error) {
+	svc, ok := spotinstClient.account.CloudProviderCommon().(*common.ServiceOp)
+	if !ok {
+		return nil, fmt.Errorf("unsupported account service implementation")
+	}
+
+	resp, err := client.RequireOK(svc.Client.DoOrg(ctx, client.NewRequest(http.MethodGet, "/setup/account")))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var rw client.Response
+	if err := json.Unmarshal(body, &rw); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*common.Account, 0, len(rw.Response.Items))
+	for _, item := range rw.Response.Items {
+		account := new(common.Account)
+		if err := json.Unmarshal(item, account); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
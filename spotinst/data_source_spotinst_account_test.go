@@ -0,0 +1,42 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccountDataSource_Lookup(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/setup/account",
+			map[string]interface{}{"id": "act-11111111", "name": "production"},
+			map[string]interface{}{"id": "act-22222222", "name": "staging"})
+	d := dataSourceSpotinstAccount()
+
+	for _, config := range []map[string]interface{}{
+		{"name": "staging"},
+		{"id": "act-22222222"},
+	} {
+		resourceData := schema.TestResourceDataRaw(t, d.Schema, config)
+		if diags := d.ReadContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+			t.Fatalf("read failed: %v", diags)
+		}
+		if got := resourceData.Id(); got != "act-22222222" {
+			t.Errorf("%v: id: got %q, want %q", config, got, "act-22222222")
+		}
+		if got := resourceData.Get("name"); got != "staging" {
+			t.Errorf("%v: name: got %v, want %q", config, got, "staging")
+		}
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"name": "development",
+	})
+	diags := d.ReadContext(context.Background(), resourceData, api.Client())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "no accounts matched") {
+		t.Errorf("expected an error for no matches, got %v", diags)
+	}
+}
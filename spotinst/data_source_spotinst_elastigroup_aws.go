@@ -0,0 +1,123 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstElastigroupAWS() *schema.Resource {
+	if commons.ElastigroupResource == nil {
+		setupElastigroupResource()
+	}
+
+	s := commons.DataSourceSchemaFromResourceSchema(commons.ElastigroupResource.GetSchemaMap())
+	commons.AddDataSourceLookupFields(s, commons.DataSourceName)
+
+	s[string(commons.DataSourceID)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	s[string(commons.DataSourceTagFilter)] = commons.DataSourceTagFilterSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstElastigroupAWSRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstElastigroupAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead),
+		commons.ElastigroupResource.GetName())
+
+	group, err := findElastigroupAWS(ctx, resourceData, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(group.ID))
+
+	if err := commons.ElastigroupResource.OnRead(group, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Elastigroup data source read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func findElastigroupAWS(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*aws.Group, error) {
+	svc := spotinstClient.elastigroup.CloudProviderAWS()
+
+	if id, ok := resourceData.GetOk(string(commons.DataSourceID)); ok {
+		resp, err := svc.Read(ctx, &aws.ReadGroupInput{GroupID: spotinst.String(id.(string))})
+		if err != nil {
+			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+				for _, err := range errs {
+					if err.Code == ErrCodeGroupNotFound {
+						return nil, fmt.Errorf("no elastigroup found with id %q", id)
+					}
+				}
+			}
+			return nil, fmt.Errorf("failed to read group: %v", err)
+		}
+		if resp.Group == nil {
+			return nil, fmt.Errorf("no elastigroup found with id %q", id)
+		}
+		return resp.Group, nil
+	}
+
+	name := resourceData.Get(string(commons.DataSourceName)).(string)
+	tagFilters := commons.ExpandDataSourceTagFilter(resourceData)
+	if name == "" && len(tagFilters) == 0 {
+		return nil, fmt.Errorf("one of %q, %q or %q must be set",
+			commons.DataSourceID, commons.DataSourceName, commons.DataSourceTagFilter)
+	}
+
+	resp, err := svc.List(ctx, &aws.ListGroupsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %v", err)
+	}
+
+	var matches []*aws.Group
+	for _, group := range resp.Groups {
+		if name != "" && spotinst.StringValue(group.Name) != name {
+			continue
+		}
+		if !commons.MatchDataSourceTags(tagFilters, elastigroupAWSTags(group)) {
+			continue
+		}
+		matches = append(matches, group)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no elastigroup matched the given criteria")
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, group := range matches {
+			ids = append(ids, spotinst.StringValue(group.ID))
+		}
+		return nil, fmt.Errorf("multiple elastigroups matched the given criteria: %v, "+
+			"please use more specific search criteria", ids)
+	}
+}
+
+func elastigroupAWSTags(group *aws.Group) map[string]string {
+	tags := make(map[string]string)
+	if group.Compute != nil && group.Compute.LaunchSpecification != nil {
+		for _, tag := range group.Compute.LaunchSpecification.Tags {
+			tags[spotinst.StringValue(tag.Key)] = spotinst.StringValue(tag.Value)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,67 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.ElastigroupAWSResourceName), name)
+}
+
+// region Elastigroup AWS data source: Lookup
+func TestAccSpotinstElastigroupAWSDataSource_Lookup(t *testing.T) {
+	groupName := "test-acc-eg-data-source"
+	resourceName := createElastigroupResourceName(groupName)
+	dataSourceName := createElastigroupDataSourceName(groupName)
+
+	var group aws.Group
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName}) +
+					fmt.Sprintf(testDataSourceElastigroupAWSConfig_ByID, groupName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "product", resourceName, "product"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_size", resourceName, "max_size"),
+				),
+			},
+			{
+				Config: createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName}) +
+					fmt.Sprintf(testDataSourceElastigroupAWSConfig_ByName, groupName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceElastigroupAWSConfig_ByID = `
+data "` + string(commons.ElastigroupAWSResourceName) + `" "%v" {
+  provider = "aws"
+  id       = ` + string(commons.ElastigroupAWSResourceName) + `.%v.id
+}
+`
+
+const testDataSourceElastigroupAWSConfig_ByName = `
+data "` + string(commons.ElastigroupAWSResourceName) + `" "%v" {
+  provider = "aws"
+  name     = ` + string(commons.ElastigroupAWSResourceName) + `.%v.name
+}
+`
+
+// endregion
@@ -0,0 +1,123 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstOceanAWS() *schema.Resource {
+	if commons.OceanAWSResource == nil {
+		setupClusterAWSResource()
+	}
+
+	s := commons.DataSourceSchemaFromResourceSchema(commons.OceanAWSResource.GetSchemaMap())
+	commons.AddDataSourceLookupFields(s, commons.DataSourceName)
+
+	s[string(commons.DataSourceID)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	s[string(commons.DataSourceTagFilter)] = commons.DataSourceTagFilterSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOceanAWSRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOceanAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead),
+		commons.OceanAWSResource.GetName())
+
+	cluster, err := findOceanAWSCluster(ctx, resourceData, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(cluster.ID))
+
+	if err := commons.OceanAWSResource.OnRead(cluster, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Cluster data source read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func findOceanAWSCluster(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*aws.Cluster, error) {
+	svc := spotinstClient.ocean.CloudProviderAWS()
+
+	if id, ok := resourceData.GetOk(string(commons.DataSourceID)); ok {
+		resp, err := svc.ReadCluster(ctx, &aws.ReadClusterInput{ClusterID: spotinst.String(id.(string))})
+		if err != nil {
+			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+				for _, err := range errs {
+					if err.Code == ErrCodeClusterNotFound {
+						return nil, fmt.Errorf("no ocean cluster found with id %q", id)
+					}
+				}
+			}
+			return nil, fmt.Errorf("failed to read cluster: %v", err)
+		}
+		if resp.Cluster == nil {
+			return nil, fmt.Errorf("no ocean cluster found with id %q", id)
+		}
+		return resp.Cluster, nil
+	}
+
+	name := resourceData.Get(string(commons.DataSourceName)).(string)
+	tagFilters := commons.ExpandDataSourceTagFilter(resourceData)
+	if name == "" && len(tagFilters) == 0 {
+		return nil, fmt.Errorf("one of %q, %q or %q must be set",
+			commons.DataSourceID, commons.DataSourceName, commons.DataSourceTagFilter)
+	}
+
+	resp, err := svc.ListClusters(ctx, &aws.ListClustersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %v", err)
+	}
+
+	var matches []*aws.Cluster
+	for _, cluster := range resp.Clusters {
+		if name != "" && spotinst.StringValue(cluster.Name) != name {
+			continue
+		}
+		if !commons.MatchDataSourceTags(tagFilters, oceanAWSClusterTags(cluster)) {
+			continue
+		}
+		matches = append(matches, cluster)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no ocean cluster matched the given criteria")
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, cluster := range matches {
+			ids = append(ids, spotinst.StringValue(cluster.ID))
+		}
+		return nil, fmt.Errorf("multiple ocean clusters matched the given criteria: %v, "+
+			"please use more specific search criteria", ids)
+	}
+}
+
+func oceanAWSClusterTags(cluster *aws.Cluster) map[string]string {
+	tags := make(map[string]string)
+	if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil {
+		for _, tag := range cluster.Compute.LaunchSpecification.Tags {
+			tags[spotinst.StringValue(tag.Key)] = spotinst.StringValue(tag.Value)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,72 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAWSDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OceanAWSResourceName), name)
+}
+
+// region OceanAWS data source: Lookup
+func TestAccSpotinstOceanAWSDataSource_Lookup(t *testing.T) {
+	clusterName := "test-acc-cluster-data-source"
+	controllerClusterID := "data-source-controller-id"
+	resourceName := createOceanAWSResourceName(clusterName)
+	dataSourceName := createOceanAWSDataSourceName(clusterName)
+
+	var cluster aws.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+				}) + fmt.Sprintf(testDataSourceOceanAWSConfig_ByID, clusterName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "controller_id", resourceName, "controller_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_size", resourceName, "max_size"),
+				),
+			},
+			{
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+				}) + fmt.Sprintf(testDataSourceOceanAWSConfig_ByName, clusterName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "region", resourceName, "region"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceOceanAWSConfig_ByID = `
+data "` + string(commons.OceanAWSResourceName) + `" "%v" {
+  provider = "aws"
+  id       = ` + string(commons.OceanAWSResourceName) + `.%v.id
+}
+`
+
+const testDataSourceOceanAWSConfig_ByName = `
+data "` + string(commons.OceanAWSResourceName) + `" "%v" {
+  provider = "aws"
+  name     = ` + string(commons.OceanAWSResourceName) + `.%v.name
+}
+`
+
+// endregion
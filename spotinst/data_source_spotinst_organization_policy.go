@@ -0,0 +1,87 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstOrgPolicy() *schema.Resource {
+	if commons.OrgPolicyResource == nil {
+		setupOrgPolicy()
+	}
+
+	s := commons.DataSourceSchemaFromResourceSchema(commons.OrgPolicyResource.GetSchemaMap())
+	commons.AddDataSourceLookupFields(s, commons.DataSourceName)
+
+	s[string(commons.DataSourceID)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrgPolicyRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOrgPolicyRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead),
+		commons.OrgPolicyResource.GetName())
+
+	policy, err := findOrgPolicy(ctx, resourceData, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(policy.PolicyID))
+
+	if err := commons.OrgPolicyResource.OnRead(policy, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Policy data source read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+// findOrgPolicy looks up a policy by its ID or name. Policies are listed in
+// full, so built-in policies are found the same way as custom ones.
+func findOrgPolicy(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*organization.Policy, error) {
+	id := resourceData.Get(string(commons.DataSourceID)).(string)
+	name := resourceData.Get(string(commons.DataSourceName)).(string)
+	if id == "" && name == "" {
+		return nil, fmt.Errorf("one of %q or %q must be set",
+			commons.DataSourceID, commons.DataSourceName)
+	}
+
+	resp, err := spotinstClient.organization.ListPolicies(ctx, &organization.ListPoliciesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %v", err)
+	}
+
+	policies := make(map[string]*organization.Policy)
+	var ids []string
+	for _, policy := range resp.Policies {
+		if id != "" && spotinst.StringValue(policy.PolicyID) != id {
+			continue
+		}
+		if name != "" && spotinst.StringValue(policy.Name) != name {
+			continue
+		}
+		policies[spotinst.StringValue(policy.PolicyID)] = policy
+		ids = append(ids, spotinst.StringValue(policy.PolicyID))
+	}
+
+	match, err := commons.SelectDataSourceMatch("policies", ids)
+	if err != nil {
+		return nil, err
+	}
+	return policies[match], nil
+}
@@ -0,0 +1,213 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	orgPolicy "github.com/spotinst/terraform-provider-spotinst/spotinst/organization_policy"
+)
+
+func dataSourceSpotinstOrgPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrgPolicyDocumentRead,
+		Schema: map[string]*schema.Schema{
+			string(orgPolicy.SourcePolicyDocuments): {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+
+			string(orgPolicy.Statement): {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(orgPolicy.Effect): {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "ALLOW",
+							ValidateFunc: validation.StringInSlice(orgPolicy.Effects, true),
+						},
+
+						string(orgPolicy.Actions): {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: orgPolicy.ValidateAction,
+							},
+						},
+
+						string(orgPolicy.Resources): {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: orgPolicy.ValidateResource,
+							},
+						},
+					},
+				},
+			},
+
+			string(orgPolicy.Statements): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(orgPolicy.Effect): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(orgPolicy.Actions): {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						string(orgPolicy.Resources): {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			string(orgPolicy.JSON): {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstOrgPolicyDocumentRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead),
+		commons.OrgPolicyDocumentDataSourceName)
+
+	var statements []*organization.Statement
+	var diags diag.Diagnostics
+
+	// Statements of the source documents come first, and are validated
+	// here as they are not part of the configuration.
+	for i, doc := range resourceData.Get(string(orgPolicy.SourcePolicyDocuments)).([]interface{}) {
+		source := new(organization.PolicyContent)
+		if err := json.Unmarshal([]byte(doc.(string)), source); err != nil {
+			return diag.Errorf("failed to parse %s.%d: %v", orgPolicy.SourcePolicyDocuments, i, err)
+		}
+
+		key := fmt.Sprintf("%s.%d", orgPolicy.SourcePolicyDocuments, i)
+		diags = append(diags, validatePolicyStatements(key, source.Statements)...)
+		statements = append(statements, source.Statements...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, item := range resourceData.Get(string(orgPolicy.Statement)).([]interface{}) {
+		m := item.(map[string]interface{})
+
+		statement := &organization.Statement{}
+		statement.SetEffect(spotinst.String(strings.ToUpper(m[string(orgPolicy.Effect)].(string))))
+		statement.SetActions(expandPolicyDocumentStrings(m[string(orgPolicy.Actions)]))
+
+		// Statements apply to every resource unless restricted.
+		resources := expandPolicyDocumentStrings(m[string(orgPolicy.Resources)])
+		if len(resources) == 0 {
+			resources = []string{"*"}
+		}
+		statement.SetResources(resources)
+
+		statements = append(statements, statement)
+	}
+
+	if len(statements) == 0 {
+		return append(diags, diag.Errorf("at least one %q block or source policy document must be set", orgPolicy.Statement)...)
+	}
+
+	content := &organization.PolicyContent{}
+	content.SetStatements(statements)
+
+	doc, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if err := resourceData.Set(string(orgPolicy.JSON), string(doc)); err != nil {
+		return append(diags, diag.Errorf(string(commons.FailureFieldReadPattern), string(orgPolicy.JSON), err)...)
+	}
+	if err := resourceData.Set(string(orgPolicy.Statements), flattenPolicyDocumentStatements(statements)); err != nil {
+		return append(diags, diag.Errorf(string(commons.FailureFieldReadPattern), string(orgPolicy.Statements), err)...)
+	}
+	resourceData.SetId(strconv.Itoa(schema.HashString(string(doc))))
+
+	log.Printf("===> Policy document data source read successfully: %s <===", resourceData.Id())
+	return diags
+}
+
+// validatePolicyStatements validates the statements of a source policy
+// document the way the policy statements are validated.
+func validatePolicyStatements(key string, statements []*organization.Statement) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	add := func(warnings []string, errs []error) {
+		for _, w := range warnings {
+			diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: w})
+		}
+		for _, err := range errs {
+			diags = append(diags, diag.Diagnostic{Severity: diag.Error, Summary: err.Error()})
+		}
+	}
+
+	for i, statement := range statements {
+		prefix := fmt.Sprintf("%s.statements.%d", key, i)
+
+		add(validation.StringInSlice(orgPolicy.Effects, true)(spotinst.StringValue(statement.Effect), prefix+".effect"))
+		for j, action := range statement.Actions {
+			add(orgPolicy.ValidateAction(action, fmt.Sprintf("%s.actions.%d", prefix, j)))
+		}
+		for j, resource := range statement.Resources {
+			add(orgPolicy.ValidateResource(resource, fmt.Sprintf("%s.resources.%d", prefix, j)))
+		}
+	}
+	return diags
+}
+
+func expandPolicyDocumentStrings(data interface{}) []string {
+	list, _ := data.([]interface{})
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok && s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func flattenPolicyDocumentStatements(statements []*organization.Statement) []interface{} {
+	result := make([]interface{}, 0, len(statements))
+	for _, statement := range statements {
+		result = append(result, map[string]interface{}{
+			string(orgPolicy.Effect):    spotinst.StringValue(statement.Effect),
+			string(orgPolicy.Actions):   statement.Actions,
+			string(orgPolicy.Resources): statement.Resources,
+		})
+	}
+	return result
+}
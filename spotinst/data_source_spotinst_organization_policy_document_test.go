@@ -0,0 +1,85 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOrganizationPolicyDocumentDataSource_Render(t *testing.T) {
+	d := dataSourceSpotinstOrgPolicyDocument()
+
+	resourceData := schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"source_policy_documents": []interface{}{
+			`{"statements": [{"effect": "ALLOW", "actions": ["*:get*", "*:list*"], "resources": ["*"]}]}`,
+		},
+		"statement": []interface{}{
+			map[string]interface{}{
+				"actions":   []interface{}{"ocean:updateCluster"},
+				"resources": []interface{}{"o-abcd1234"},
+			},
+			map[string]interface{}{
+				"effect":  "deny",
+				"actions": []interface{}{"ocean:deleteCluster"},
+			},
+		},
+	})
+	if diags := d.ReadContext(context.Background(), resourceData, nil); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(resourceData.Get("json").(string)), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"statements": []interface{}{
+			map[string]interface{}{"effect": "ALLOW", "actions": []interface{}{"*:get*", "*:list*"}, "resources": []interface{}{"*"}},
+			map[string]interface{}{"effect": "ALLOW", "actions": []interface{}{"ocean:updateCluster"}, "resources": []interface{}{"o-abcd1234"}},
+			map[string]interface{}{"effect": "DENY", "actions": []interface{}{"ocean:deleteCluster"}, "resources": []interface{}{"*"}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("json: got %v, want %v", got, want)
+	}
+
+	if got := resourceData.Get("statements.2.effect"); got != "DENY" {
+		t.Errorf("statements.2.effect: got %v, want DENY", got)
+	}
+	if resourceData.Id() == "" {
+		t.Error("id is not set")
+	}
+}
+
+func TestOrganizationPolicyDocumentDataSource_ValidatesSourceDocuments(t *testing.T) {
+	d := dataSourceSpotinstOrgPolicyDocument()
+
+	resourceData := schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"source_policy_documents": []interface{}{
+			`{"statements": [{"effect": "ALLOW", "actions": ["ocean:updateClustr"], "resources": ["*"]}]}`,
+		},
+	})
+	diags := d.ReadContext(context.Background(), resourceData, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if len(diags) != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if want := `did you mean "ocean:updateCluster"?`; !strings.HasSuffix(diags[0].Summary, want) {
+		t.Errorf("warning: got %q, want it to end with %q", diags[0].Summary, want)
+	}
+
+	resourceData = schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"source_policy_documents": []interface{}{
+			`{"statements": [{"effect": "ALLOW", "actions": ["getGroup"], "resources": ["*"]}]}`,
+		},
+	})
+	if diags := d.ReadContext(context.Background(), resourceData, nil); !diags.HasError() {
+		t.Error("expected an error for a malformed action")
+	}
+}
@@ -0,0 +1,59 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOrganizationPolicyDataSource_LookupByName(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/setup/organization/policy",
+			map[string]interface{}{"id": "1", "name": "Account Editor"},
+			map[string]interface{}{"id": "3", "name": "Account Viewer", "description": "Read only access",
+				"policyContent": map[string]interface{}{
+					"statements": []interface{}{
+						map[string]interface{}{
+							"effect":    "ALLOW",
+							"actions":   []interface{}{"*:get*", "*:list*"},
+							"resources": []interface{}{"*"},
+						},
+					},
+				}},
+			map[string]interface{}{"id": "pol-12345678", "name": "Custom"},
+			map[string]interface{}{"id": "pol-87654321", "name": "Custom"})
+	d := dataSourceSpotinstOrgPolicy()
+
+	resourceData := schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"name": "Account Viewer",
+	})
+	if diags := d.ReadContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if got := resourceData.Id(); got != "3" {
+		t.Errorf("id: got %q, want %q", got, "3")
+	}
+	content := resourceData.Get("policy_content").(*schema.Set).List()
+	if len(content) != 1 {
+		t.Fatalf("policy_content: got %d elements, want 1", len(content))
+	}
+	statements := content[0].(map[string]interface{})["statements"].(*schema.Set).List()
+	if len(statements) != 1 {
+		t.Fatalf("statements: got %d elements, want 1", len(statements))
+	}
+	if got := statements[0].(map[string]interface{})["actions"]; !reflect.DeepEqual(got, []interface{}{"*:get*", "*:list*"}) {
+		t.Errorf("actions: got %v, want [*:get* *:list*]", got)
+	}
+
+	resourceData = schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"name": "Custom",
+	})
+	diags := d.ReadContext(context.Background(), resourceData, api.Client())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "multiple policies matched") {
+		t.Errorf("expected an error for multiple matches, got %v", diags)
+	}
+}
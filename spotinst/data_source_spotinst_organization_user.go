@@ -0,0 +1,113 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	orgUser "github.com/spotinst/terraform-provider-spotinst/spotinst/organization_user"
+)
+
+func dataSourceSpotinstOrgUser() *schema.Resource {
+	if commons.OrgUserResource == nil {
+		setupOrgUser()
+	}
+
+	s := commons.DataSourceSchemaFromResourceSchema(commons.OrgUserResource.GetSchemaMap())
+	delete(s, string(orgUser.Password))
+	commons.AddDataSourceLookupFields(s, orgUser.Email)
+
+	s[string(commons.DataSourceID)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrgUserRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOrgUserRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead),
+		commons.OrgUserResource.GetName())
+
+	user, err := findOrgUser(ctx, resourceData, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(user.UserID))
+
+	if err := commons.OrgUserResource.OnRead(user, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The resource does not read back the details of users, as they are
+	// only set on creation.
+	for field, value := range map[commons.FieldName]*string{
+		orgUser.Email:     user.Email,
+		orgUser.FirstName: user.FirstName,
+		orgUser.LastName:  user.LastName,
+		orgUser.Role:      user.Role,
+	} {
+		if err := resourceData.Set(string(field), spotinst.StringValue(value)); err != nil {
+			return diag.Errorf(string(commons.FailureFieldReadPattern), string(field), err)
+		}
+	}
+
+	log.Printf("===> User data source read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+// findOrgUser looks up a user by its ID or email. The user found is read on
+// its own, as listed users omit their policies and user groups.
+func findOrgUser(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*organization.User, error) {
+	id := resourceData.Get(string(commons.DataSourceID)).(string)
+	email := resourceData.Get(string(orgUser.Email)).(string)
+	if id == "" && email == "" {
+		return nil, fmt.Errorf("one of %q or %q must be set",
+			commons.DataSourceID, orgUser.Email)
+	}
+
+	if id == "" {
+		resp, err := spotinstClient.organization.ListUsers(ctx, &organization.ListUsersInput{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %v", err)
+		}
+
+		var ids []string
+		for _, user := range resp.Users {
+			if strings.EqualFold(spotinst.StringValue(user.Email), email) {
+				ids = append(ids, spotinst.StringValue(user.UserID))
+			}
+		}
+
+		if id, err = commons.SelectDataSourceMatch("users", ids); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := spotinstClient.organization.ReadUser(ctx, &organization.ReadUserInput{
+		UserID: spotinst.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read user: %v", err)
+	}
+	if resp.User == nil {
+		return nil, fmt.Errorf("no user found with id %q", id)
+	}
+	if email != "" && !strings.EqualFold(spotinst.StringValue(resp.User.Email), email) {
+		return nil, fmt.Errorf("user %q does not have the email %q", id, email)
+	}
+
+	resp.User.UserID = spotinst.String(id)
+	return resp.User, nil
+}
@@ -0,0 +1,98 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstOrgUserGroup() *schema.Resource {
+	if commons.OrgUserGroupResource == nil {
+		setupOrgUserGroup()
+	}
+
+	s := commons.DataSourceSchemaFromResourceSchema(commons.OrgUserGroupResource.GetSchemaMap())
+	commons.AddDataSourceLookupFields(s, commons.DataSourceName)
+
+	s[string(commons.DataSourceID)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrgUserGroupRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOrgUserGroupRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead),
+		commons.OrgUserGroupResource.GetName())
+
+	userGroup, err := findOrgUserGroup(ctx, resourceData, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(userGroup.UserGroupId))
+
+	if err := commons.OrgUserGroupResource.OnRead(userGroup, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> User Group data source read successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+// findOrgUserGroup looks up a user group by its ID or name. The user group
+// found is read on its own, as listed user groups omit their users and
+// policies.
+func findOrgUserGroup(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*organization.UserGroup, error) {
+	id := resourceData.Get(string(commons.DataSourceID)).(string)
+	name := resourceData.Get(string(commons.DataSourceName)).(string)
+	if id == "" && name == "" {
+		return nil, fmt.Errorf("one of %q or %q must be set",
+			commons.DataSourceID, commons.DataSourceName)
+	}
+
+	if id == "" {
+		resp, err := spotinstClient.organization.ListUserGroups(ctx, &organization.ListUserGroupsInput{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user groups: %v", err)
+		}
+
+		var ids []string
+		for _, userGroup := range resp.UserGroups {
+			if spotinst.StringValue(userGroup.Name) == name {
+				ids = append(ids, spotinst.StringValue(userGroup.UserGroupId))
+			}
+		}
+
+		if id, err = commons.SelectDataSourceMatch("user groups", ids); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := spotinstClient.organization.ReadUserGroup(ctx, &organization.ReadUserGroupInput{
+		UserGroupID: spotinst.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read user group: %v", err)
+	}
+	if resp.UserGroup == nil {
+		return nil, fmt.Errorf("no user group found with id %q", id)
+	}
+	if name != "" && spotinst.StringValue(resp.UserGroup.Name) != name {
+		return nil, fmt.Errorf("user group %q is not named %q", id, name)
+	}
+
+	resp.UserGroup.UserGroupId = spotinst.String(id)
+	return resp.UserGroup, nil
+}
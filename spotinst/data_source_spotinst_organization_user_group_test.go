@@ -0,0 +1,35 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOrganizationUserGroupDataSource_LookupByName(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/setup/access/userGroup",
+			map[string]interface{}{"id": "ugr-11111111", "name": "admins"},
+			map[string]interface{}{"id": "ugr-22222222", "name": "developers"}).
+		Respond(http.MethodGet, "/setup/access/userGroup/ugr-22222222", map[string]interface{}{
+			"name":    "developers",
+			"userIds": []interface{}{"u-12345678"},
+		})
+	d := dataSourceSpotinstOrgUserGroup()
+
+	resourceData := schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"name": "developers",
+	})
+	if diags := d.ReadContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	if got := resourceData.Id(); got != "ugr-22222222" {
+		t.Errorf("id: got %q, want %q", got, "ugr-22222222")
+	}
+	if got := resourceData.Get("user_ids.0"); got != "u-12345678" {
+		t.Errorf("user_ids: got %v, want %q", got, "u-12345678")
+	}
+}
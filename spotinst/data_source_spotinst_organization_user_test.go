@@ -0,0 +1,43 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOrganizationUserDataSource_LookupByEmail(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/setup/organization/user",
+			map[string]interface{}{"userId": "u-11111111", "email": "alice@example.com"},
+			map[string]interface{}{"userId": "u-22222222", "email": "bob@example.com"}).
+		Respond(http.MethodGet, "/setup/user/u-22222222", map[string]interface{}{
+			"email":        "bob@example.com",
+			"firstName":    "Bob",
+			"lastName":     "Smith",
+			"role":         "viewer",
+			"userGroupIds": []interface{}{"ugr-12345678"},
+		})
+	d := dataSourceSpotinstOrgUser()
+
+	resourceData := schema.TestResourceDataRaw(t, d.Schema, map[string]interface{}{
+		"email": "Bob@Example.com",
+	})
+	if diags := d.ReadContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	for key, want := range map[string]interface{}{
+		"id":               "u-22222222",
+		"email":            "bob@example.com",
+		"first_name":       "Bob",
+		"role":             "viewer",
+		"user_group_ids.0": "ugr-12345678",
+	} {
+		if got := resourceData.Get(key); got != want {
+			t.Errorf("%s: got %v, want %v", key, got, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package elastigroup_aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -1090,6 +1091,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[DesiredCapacity].AddDiffValidator(validateCapacity)
+	fieldsMap[WaitForCapacity].AddDiffValidator(validateWaitForCapacity)
+	fieldsMap[UpdatePolicy].AddDiffValidator(validateRollConfig)
 }
 
 var TargetGroupArnRegex = regexp.MustCompile(`arn:aws:elasticloadbalancing:.*:\d{12}:targetgroup/(.*)/.*`)
@@ -1384,3 +1389,35 @@ func extractTargetGroupFromArn(arn string) (string, error) {
 	}
 	return name, nil
 }
+
+func validateCapacity(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	return commons.ValidateDiffCapacity(resourceDiff, MinSize, DesiredCapacity, MaxSize)
+}
+
+func validateWaitForCapacity(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	if err := commons.ValidateDiffRequiredTogether(resourceDiff,
+		string(WaitForCapacity), string(WaitForCapacityTimeout)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffLessOrEqual(resourceDiff, string(WaitForCapacity), string(DesiredCapacity))
+}
+
+func validateRollConfig(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	rollConfig := fmt.Sprintf("%s.0.%s.0", UpdatePolicy, RollConfig)
+
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, BatchSizePercentage)); err != nil {
+		return err
+	}
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s.0.%s", rollConfig, Strategy, BatchMinHealthyPercentage)); err != nil {
+		return err
+	}
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffRequiredTogether(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct),
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollTimeout))
+}
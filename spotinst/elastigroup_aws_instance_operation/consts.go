@@ -0,0 +1,27 @@
+package elastigroup_aws_instance_operation
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	GroupID             commons.FieldName = "group_id"
+	AffectedInstanceIDs commons.FieldName = "affected_instance_ids"
+)
+
+const (
+	Detach                        commons.FieldName = "detach"
+	InstanceIDs                   commons.FieldName = "instance_ids"
+	ShouldDecrementTargetCapacity commons.FieldName = "should_decrement_target_capacity"
+	ShouldTerminateInstances      commons.FieldName = "should_terminate_instances"
+	DrainingTimeout               commons.FieldName = "draining_timeout"
+)
+
+const (
+	Scale      commons.FieldName = "scale"
+	ScaleType  commons.FieldName = "type"
+	Adjustment commons.FieldName = "adjustment"
+)
+
+const (
+	ScaleTypeUp   = "up"
+	ScaleTypeDown = "down"
+)
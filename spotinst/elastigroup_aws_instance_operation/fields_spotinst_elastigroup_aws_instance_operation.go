@@ -0,0 +1,183 @@
+package elastigroup_aws_instance_operation
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[GroupID] = commons.NewGenericField(
+		commons.ElastigroupAWSInstanceOperation,
+		GroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			operationWrapper := resourceObject.(*commons.ElastigroupAWSInstanceOperationWrapper)
+			operationWrapper.GroupID = spotinst.String(resourceData.Get(string(GroupID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Detach] = commons.NewGenericField(
+		commons.ElastigroupAWSInstanceOperation,
+		Detach,
+		&schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{string(Detach), string(Scale)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(InstanceIDs): {
+						Type:     schema.TypeSet,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+
+					string(ShouldDecrementTargetCapacity): {
+						Type:     schema.TypeBool,
+						Required: true,
+						ForceNew: true,
+					},
+
+					string(ShouldTerminateInstances): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					string(DrainingTimeout): {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			operationWrapper := resourceObject.(*commons.ElastigroupAWSInstanceOperationWrapper)
+			if v, ok := resourceData.GetOk(string(Detach)); ok {
+				operationWrapper.Detach = expandDetach(v)
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Scale] = commons.NewGenericField(
+		commons.ElastigroupAWSInstanceOperation,
+		Scale,
+		&schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{string(Detach), string(Scale)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ScaleType): {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{ScaleTypeUp, ScaleTypeDown}, false),
+					},
+
+					string(Adjustment): {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			operationWrapper := resourceObject.(*commons.ElastigroupAWSInstanceOperationWrapper)
+			if v, ok := resourceData.GetOk(string(Scale)); ok {
+				operationWrapper.Scale = expandScale(v)
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[AffectedInstanceIDs] = commons.NewGenericField(
+		commons.ElastigroupAWSInstanceOperation,
+		AffectedInstanceIDs,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func expandDetach(data interface{}) *aws.DetachGroupInput {
+	detach := &aws.DetachGroupInput{}
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return detach
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(InstanceIDs)].(*schema.Set); ok {
+		for _, id := range v.List() {
+			detach.InstanceIDs = append(detach.InstanceIDs, id.(string))
+		}
+	}
+
+	if v, ok := m[string(ShouldDecrementTargetCapacity)].(bool); ok {
+		detach.ShouldDecrementTargetCapacity = spotinst.Bool(v)
+	}
+
+	if v, ok := m[string(ShouldTerminateInstances)].(bool); ok {
+		detach.ShouldTerminateInstances = spotinst.Bool(v)
+	}
+
+	if v, ok := m[string(DrainingTimeout)].(int); ok && v > 0 {
+		detach.DrainingTimeout = spotinst.Int(v)
+	}
+
+	return detach
+}
+
+func expandScale(data interface{}) *aws.ScaleGroupInput {
+	scale := &aws.ScaleGroupInput{}
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return scale
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(ScaleType)].(string); ok && v != "" {
+		scale.ScaleType = spotinst.String(v)
+	}
+
+	if v, ok := m[string(Adjustment)].(int); ok && v > 0 {
+		scale.Adjustment = spotinst.Int(v)
+	}
+
+	return scale
+}
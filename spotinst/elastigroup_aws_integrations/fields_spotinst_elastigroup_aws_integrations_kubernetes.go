@@ -36,8 +36,9 @@ func SetupKubernetes(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(Token): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
 					},
 
 					string(AutoscaleIsEnabled): {
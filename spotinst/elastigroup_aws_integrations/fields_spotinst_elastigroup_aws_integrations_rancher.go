@@ -26,13 +26,15 @@ func SetupRancher(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(AccessKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(SecretKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(Version): {
@@ -47,7 +49,8 @@ func SetupRancher(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var value []interface{} = nil
 			if elastigroup.Integration != nil && elastigroup.Integration.Rancher != nil {
-				value = flattenAWSGroupRancherIntegration(elastigroup.Integration.Rancher)
+				value = commons.KeepSensitiveValues(resourceData, string(IntegrationRancher),
+					flattenAWSGroupRancherIntegration(elastigroup.Integration.Rancher), AccessKey, SecretKey)
 			}
 			if value != nil {
 				if err := resourceData.Set(string(IntegrationRancher), value); err != nil {
@@ -1019,10 +1019,31 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	// Schema version 0 kept the empty SHA1 sum returned by the EC2 API for the
+	// scripts of groups without any.
+	fieldsMap[UserData].AddStateUpgrader(&commons.FieldStateUpgrader{
+		Version: 0,
+		Upgrade: commons.NormalizeFieldState(UserData, normalizeEmptySHA1),
+	})
+	fieldsMap[ShutdownScript].AddStateUpgrader(&commons.FieldStateUpgrader{
+		Version: 0,
+		Upgrade: commons.NormalizeFieldState(ShutdownScript, normalizeEmptySHA1),
+	})
 }
 
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
 
+// emptySHA1 is the SHA1 sum of an empty script.
+const emptySHA1 = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+
+func normalizeEmptySHA1(v interface{}) interface{} {
+	if s, ok := v.(string); ok && s == emptySHA1 {
+		return ""
+	}
+	return v
+}
+
 func Base64StateFunc(v interface{}) string {
 	if isBase64Encoded(v.(string)) {
 		return v.(string)
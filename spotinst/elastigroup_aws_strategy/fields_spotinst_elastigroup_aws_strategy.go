@@ -1,12 +1,14 @@
 package elastigroup_aws_strategy
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -593,6 +595,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[OnDemandCount].AddDiffValidator(validateOnDemandCount)
 }
 
 func flattenAWSGroupScalingStrategy(strategy *aws.ScalingStrategy) []interface{} {
@@ -618,3 +622,7 @@ func expandAWSGroupScalingStrategy(data interface{}) (*aws.ScalingStrategy, erro
 	}
 	return strategy, nil
 }
+
+func validateOnDemandCount(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	return commons.ValidateDiffLessOrEqual(resourceDiff, string(OnDemandCount), string(elastigroup_aws.MaxSize))
+}
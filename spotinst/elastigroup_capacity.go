@@ -0,0 +1,152 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// elastigroupHealthReader returns the number of healthy instances of a group,
+// and the total number of its instances.
+type elastigroupHealthReader func(ctx context.Context) (healthy, total int, err error)
+
+// waitForElastigroupCapacity waits for the number of healthy instances set in
+// the capacity field of the resource, for at most the seconds set in its
+// timeout field. It does not wait when either of the fields is not set.
+func waitForElastigroupCapacity(ctx context.Context, resourceData *schema.ResourceData, capacityField, timeoutField commons.FieldName, read elastigroupHealthReader) error {
+	capacity, _ := resourceData.Get(string(capacityField)).(int)
+	timeout, _ := resourceData.Get(string(timeoutField)).(int)
+	return awaitElastigroupCapacity(ctx, resourceData.Id(), capacity, timeout, read)
+}
+
+// awaitElastigroupCapacity polls the instances of a group until at least
+// capacity of them are healthy, or fails after timeout seconds with the
+// number of instances that are not healthy yet.
+func awaitElastigroupCapacity(ctx context.Context, groupID string, capacity, timeout int, read elastigroupHealthReader) error {
+	if capacity == 0 || timeout == 0 {
+		return nil
+	}
+
+	var healthy, total int
+	var readErr error
+	err := resource.RetryContext(ctx, time.Second*time.Duration(timeout), func() *resource.RetryError {
+		healthy, total, readErr = read(ctx)
+		if readErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitElastigroupCapacity() -> status of group [%v] API call failed, error: %v", groupID, readErr))
+		}
+
+		if healthy < capacity {
+			log.Printf("===> waiting for %d more healthy instances <===\n", capacity-healthy)
+			return resource.RetryableError(fmt.Errorf("===> waiting for %d more healthy instances <===", capacity-healthy))
+		}
+
+		log.Printf("awaitElastigroupCapacity() -> Target number of health instances reached [%v]", groupID)
+		return nil
+	})
+	if err != nil && readErr == nil {
+		// RetryContext returns the last retryable error when timing out,
+		// which does not tell how many instances are still not healthy.
+		return fmt.Errorf("[ERROR] Instances not ready: %d of %d instances of group [%v] are healthy (%d unhealthy), "+
+			"waiting for %d healthy instances", healthy, total, groupID, total-healthy, capacity)
+	}
+	return err
+}
+
+// waitForGCPGroupCapacity waits for the healthy capacity of a GCP or GKE group.
+func waitForGCPGroupCapacity(ctx context.Context, resourceData *schema.ResourceData, meta interface{}, capacityField, timeoutField commons.FieldName) error {
+	return waitForElastigroupCapacity(ctx, resourceData, capacityField, timeoutField,
+		readGCPGroupHealth(meta.(*Client).elastigroup.CloudProviderGCP(), resourceData.Id()))
+}
+
+// readGCPGroupHealth counts the instances of a GCP or GKE group, considering
+// the running ones healthy.
+func readGCPGroupHealth(svc gcp.Service, groupID string) elastigroupHealthReader {
+	return func(ctx context.Context) (int, int, error) {
+		status, err := svc.Status(ctx, &gcp.StatusGroupInput{GroupID: spotinst.String(groupID)})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		healthy := 0
+		for _, instance := range status.Instances {
+			if strings.EqualFold(spotinst.StringValue(instance.StatusName), "RUNNING") {
+				healthy++
+			}
+		}
+		return healthy, len(status.Instances), nil
+	}
+}
+
+// waitForAzureV3GroupCapacity waits for the healthy capacity of an Azure group.
+func waitForAzureV3GroupCapacity(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	svc, ok := meta.(*Client).elastigroup.CloudProviderAzureV3().(*v3.ServiceOp)
+	if !ok {
+		return fmt.Errorf("unsupported elastigroup/azure/v3 service implementation")
+	}
+	return waitForElastigroupCapacity(ctx, resourceData, elastigroup_azure.WaitForCapacity, elastigroup_azure.WaitForCapacityTimeout,
+		readAzureV3GroupHealth(svc.Client, resourceData.Id()))
+}
+
+// elastigroupAzureV3VMStatus is the status of a VM of an Azure group. The SDK
+// does not expose the status of Azure groups, so it is read directly using
+// the underlying API client of the group service.
+type elastigroupAzureV3VMStatus struct {
+	VMName     *string `json:"vmName,omitempty"`
+	PowerState *string `json:"powerState,omitempty"`
+}
+
+// readAzureV3GroupHealth counts the VMs of an Azure group, considering the
+// running ones healthy.
+func readAzureV3GroupHealth(c *client.Client, groupID string) elastigroupHealthReader {
+	return func(ctx context.Context) (int, int, error) {
+		path, err := uritemplates.Expand("/azure/compute/group/{groupId}/status", uritemplates.Values{
+			"groupId": groupID,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		resp, err := client.RequireOK(c.Do(ctx, client.NewRequest(http.MethodGet, path)))
+		if err != nil {
+			return 0, 0, err
+		}
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		var rw client.Response
+		if err := json.Unmarshal(body, &rw); err != nil {
+			return 0, 0, err
+		}
+
+		healthy := 0
+		for _, item := range rw.Response.Items {
+			var vm elastigroupAzureV3VMStatus
+			if err := json.Unmarshal(item, &vm); err != nil {
+				return 0, 0, err
+			}
+			if strings.Contains(strings.ToLower(spotinst.StringValue(vm.PowerState)), "running") {
+				healthy++
+			}
+		}
+		return healthy, len(rw.Response.Items), nil
+	}
+}
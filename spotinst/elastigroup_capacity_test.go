@@ -0,0 +1,40 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	v3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
+)
+
+func TestAwaitElastigroupCapacity_GCP(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/gcp/gce/group/sig-12345678/status",
+			map[string]interface{}{"instanceName": "vm-1", "statusName": "RUNNING"},
+			map[string]interface{}{"instanceName": "vm-2", "statusName": "RUNNING"},
+			map[string]interface{}{"instanceName": "vm-3", "statusName": "STAGING"})
+
+	read := readGCPGroupHealth(api.Client().elastigroup.CloudProviderGCP(), "sig-12345678")
+	if err := awaitElastigroupCapacity(context.Background(), "sig-12345678", 2, 10, read); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAwaitElastigroupCapacity_AzureV3Timeout(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/azure/compute/group/sig-12345678/status",
+			map[string]interface{}{"vmName": "vm-1", "powerState": "VM running"},
+			map[string]interface{}{"vmName": "vm-2", "powerState": "VM starting"})
+
+	svc := api.Client().elastigroup.CloudProviderAzureV3().(*v3.ServiceOp)
+	read := readAzureV3GroupHealth(svc.Client, "sig-12345678")
+	err := awaitElastigroupCapacity(context.Background(), "sig-12345678", 2, 1, read)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if want := "1 of 2 instances of group [sig-12345678] are healthy (1 unhealthy)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error: got %q, want it to contain %q", err, want)
+	}
+}
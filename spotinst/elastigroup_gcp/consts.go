@@ -18,4 +18,7 @@ const (
 	SubnetNames                commons.FieldName = "subnet_names"
 	UnhealthyDuration          commons.FieldName = "unhealthy_duration"
 	PreferredAvailabilityZones commons.FieldName = "preferred_availability_zones"
+
+	WaitForCapacity        commons.FieldName = "wait_for_capacity"
+	WaitForCapacityTimeout commons.FieldName = "wait_for_capacity_timeout"
 )
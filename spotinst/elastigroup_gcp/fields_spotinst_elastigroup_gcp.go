@@ -1,6 +1,7 @@
 package elastigroup_gcp
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -543,6 +544,28 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[WaitForCapacity] = commons.NewGenericField(
+		commons.ElastigroupGCP,
+		WaitForCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCapacityTimeout] = commons.NewGenericField(
+		commons.ElastigroupGCP,
+		WaitForCapacityTimeout,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCapacity].AddDiffValidator(validateWaitForCapacity)
 }
 
 // expandSubnets expands the list of subnet objects
@@ -573,3 +596,11 @@ func expandSubnets(data interface{}) ([]*gcp.Subnet, error) {
 	}
 	return out, nil
 }
+
+func validateWaitForCapacity(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	if err := commons.ValidateDiffRequiredTogether(resourceDiff,
+		string(WaitForCapacity), string(WaitForCapacityTimeout)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffLessOrEqual(resourceDiff, string(WaitForCapacity), string(TargetCapacity))
+}
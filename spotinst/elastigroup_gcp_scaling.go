@@ -0,0 +1,420 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gcp_scaling_policies"
+)
+
+// The SDK does not expose the target policies and the multiple metrics of the
+// scaling of GCP and GKE Elastigroups, so they are managed directly using the
+// underlying API client of the group service. They have the shape of the
+// target policies and the multiple metrics of the AWS groups in the SDK, and
+// are sent as a partial update of the group scaling.
+
+const gcpGroupScalingPath = "/gcp/gce/group/{groupId}"
+
+// gcpTargetScalingPolicy is a target policy of a GCP Elastigroup. It has the
+// shape of a target aws.ScalingPolicy of the SDK.
+type gcpTargetScalingPolicy struct {
+	PolicyName          *string          `json:"policyName,omitempty"`
+	MetricName          *string          `json:"metricName,omitempty"`
+	Namespace           *string          `json:"namespace,omitempty"`
+	Source              *string          `json:"source,omitempty"`
+	Statistic           *string          `json:"statistic,omitempty"`
+	Unit                *string          `json:"unit,omitempty"`
+	Cooldown            *int             `json:"cooldown,omitempty"`
+	Dimensions          []*gcp.Dimension `json:"dimensions,omitempty"`
+	Target              *float64         `json:"target,omitempty"`
+	Period              *int             `json:"period,omitempty"`
+	EvaluationPeriods   *int             `json:"evaluationPeriods,omitempty"`
+	MaxCapacityPerScale *string          `json:"maxCapacityPerScale,omitempty"`
+	Predictive          *gcpPredictive   `json:"predictive,omitempty"`
+}
+
+type gcpPredictive struct {
+	Mode *string `json:"mode,omitempty"`
+}
+
+// gcpMultipleMetrics are the metrics and expressions the policies of a GCP
+// Elastigroup may refer to. They have the shape of aws.MultipleMetrics of the
+// SDK.
+type gcpMultipleMetrics struct {
+	Metrics     []*gcpMetric     `json:"metrics,omitempty"`
+	Expressions []*gcpExpression `json:"expressions,omitempty"`
+}
+
+type gcpMetric struct {
+	Name              *string          `json:"name,omitempty"`
+	MetricName        *string          `json:"metricName,omitempty"`
+	Namespace         *string          `json:"namespace,omitempty"`
+	Dimensions        []*gcp.Dimension `json:"dimensions,omitempty"`
+	ExtendedStatistic *string          `json:"extendedStatistic,omitempty"`
+	Statistic         *string          `json:"statistic,omitempty"`
+	Unit              *string          `json:"unit,omitempty"`
+}
+
+type gcpExpression struct {
+	Expression *string `json:"expression,omitempty"`
+	Name       *string `json:"name,omitempty"`
+}
+
+// gcpGroupScaling is the part of the scaling of a GCP Elastigroup that the SDK
+// does not model.
+type gcpGroupScaling struct {
+	Target          []*gcpTargetScalingPolicy `json:"target,omitempty"`
+	MultipleMetrics *gcpMultipleMetrics       `json:"multipleMetrics,omitempty"`
+}
+
+// onCreateGCPGroupScaling sets the target policies and the multiple metrics of
+// a created group, when any are configured.
+func onCreateGCPGroupScaling(ctx context.Context, resourceData *schema.ResourceData, c *client.Client) error {
+	scaling := make(map[string]interface{})
+	if v, ok := resourceData.GetOk(string(elastigroup_gcp_scaling_policies.ScalingTargetPolicy)); ok {
+		scaling["target"] = expandGCPTargetScalingPolicies(v)
+	}
+	if v, ok := resourceData.GetOk(string(elastigroup_gcp_scaling_policies.MultipleMetrics)); ok {
+		if multipleMetrics := expandGCPMultipleMetrics(v); multipleMetrics != nil {
+			scaling["multipleMetrics"] = multipleMetrics
+		}
+	}
+	if len(scaling) == 0 {
+		return nil
+	}
+	return updateGCPGroupScaling(ctx, c, resourceData.Id(), scaling)
+}
+
+// onReadGCPGroupScaling reads the target policies and the multiple metrics of a
+// group into the resource.
+func onReadGCPGroupScaling(ctx context.Context, resourceData *schema.ResourceData, c *client.Client) error {
+	path, err := uritemplates.Expand(gcpGroupScalingPath, uritemplates.Values{
+		"groupId": resourceData.Id(),
+	})
+	if err != nil {
+		return err
+	}
+
+	items, err := doElastigroupRequest(ctx, c, client.NewRequest(http.MethodGet, path))
+	if err != nil {
+		return fmt.Errorf("failed to read scaling of group [%v]: %v", resourceData.Id(), err)
+	}
+
+	scaling := &gcpGroupScaling{}
+	if len(items) > 0 {
+		group := &struct {
+			Scaling *gcpGroupScaling `json:"scaling,omitempty"`
+		}{}
+		if err := json.Unmarshal(items[0], group); err != nil {
+			return err
+		}
+		if group.Scaling != nil {
+			scaling = group.Scaling
+		}
+	}
+
+	var targetPolicies []interface{} = nil
+	if scaling.Target != nil {
+		targetPolicies = flattenGCPTargetScalingPolicies(scaling.Target)
+	}
+	if err := resourceData.Set(string(elastigroup_gcp_scaling_policies.ScalingTargetPolicy), targetPolicies); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(elastigroup_gcp_scaling_policies.ScalingTargetPolicy), err)
+	}
+
+	var multipleMetrics []interface{} = nil
+	if scaling.MultipleMetrics != nil {
+		multipleMetrics = flattenGCPMultipleMetrics(scaling.MultipleMetrics)
+	}
+	if err := resourceData.Set(string(elastigroup_gcp_scaling_policies.MultipleMetrics), multipleMetrics); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(elastigroup_gcp_scaling_policies.MultipleMetrics), err)
+	}
+	return nil
+}
+
+// onUpdateGCPGroupScaling updates the target policies and the multiple metrics
+// of a group when they changed. Removed ones are sent as null to clear them.
+func onUpdateGCPGroupScaling(ctx context.Context, resourceData *schema.ResourceData, c *client.Client) error {
+	scaling := make(map[string]interface{})
+	if resourceData.HasChange(string(elastigroup_gcp_scaling_policies.ScalingTargetPolicy)) {
+		var value []*gcpTargetScalingPolicy = nil
+		if v, ok := resourceData.GetOk(string(elastigroup_gcp_scaling_policies.ScalingTargetPolicy)); ok {
+			value = expandGCPTargetScalingPolicies(v)
+		}
+		scaling["target"] = value
+	}
+	if resourceData.HasChange(string(elastigroup_gcp_scaling_policies.MultipleMetrics)) {
+		var value *gcpMultipleMetrics = nil
+		if v, ok := resourceData.GetOk(string(elastigroup_gcp_scaling_policies.MultipleMetrics)); ok {
+			value = expandGCPMultipleMetrics(v)
+		}
+		scaling["multipleMetrics"] = value
+	}
+	if len(scaling) == 0 {
+		return nil
+	}
+	if err := updateGCPGroupScaling(ctx, c, resourceData.Id(), scaling); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update scaling of group [%v]: %v", resourceData.Id(), err)
+	}
+	return nil
+}
+
+func updateGCPGroupScaling(ctx context.Context, c *client.Client, groupID string, scaling map[string]interface{}) error {
+	path, err := uritemplates.Expand(gcpGroupScalingPath, uritemplates.Values{
+		"groupId": groupID,
+	})
+	if err != nil {
+		return err
+	}
+
+	r := client.NewRequest(http.MethodPut, path)
+	r.Obj = map[string]interface{}{
+		"group": map[string]interface{}{"scaling": scaling},
+	}
+
+	if json, err := commons.ToJson(scaling); err == nil {
+		log.Printf("===> Group [%v] scaling configuration: %s", groupID, json)
+	}
+
+	_, err = doElastigroupRequest(ctx, c, r)
+	return err
+}
+
+func expandGCPTargetScalingPolicies(data interface{}) []*gcpTargetScalingPolicy {
+	list := data.(*schema.Set).List()
+	policies := make([]*gcpTargetScalingPolicy, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		policy := &gcpTargetScalingPolicy{}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.PolicyName)].(string); ok && v != "" {
+			policy.PolicyName = spotinst.String(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.MetricName)].(string); ok && v != "" {
+			policy.MetricName = spotinst.String(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Namespace)].(string); ok && v != "" {
+			policy.Namespace = spotinst.String(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Source)].(string); ok && v != "" {
+			policy.Source = spotinst.String(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Statistic)].(string); ok && v != "" {
+			policy.Statistic = spotinst.String(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Unit)].(string); ok && v != "" {
+			policy.Unit = spotinst.String(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Cooldown)].(int); ok && v > 0 {
+			policy.Cooldown = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Dimensions)].([]interface{}); ok {
+			if dimensions := expandGCPScalingDimensions(v); len(dimensions) > 0 {
+				policy.Dimensions = dimensions
+			}
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Target)].(float64); ok {
+			policy.Target = spotinst.Float64(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.Period)].(int); ok && v > 0 {
+			policy.Period = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.EvaluationPeriods)].(int); ok && v > 0 {
+			policy.EvaluationPeriods = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.MaxCapacityPerScale)].(string); ok && v != "" {
+			policy.MaxCapacityPerScale = spotinst.String(v)
+		}
+
+		if v, ok := m[string(elastigroup_gcp_scaling_policies.PredictiveMode)].(string); ok && v != "" {
+			policy.Predictive = &gcpPredictive{Mode: spotinst.String(v)}
+		}
+
+		if policy.Namespace != nil {
+			policies = append(policies, policy)
+		}
+	}
+	return policies
+}
+
+func expandGCPMultipleMetrics(data interface{}) *gcpMultipleMetrics {
+	list := data.(*schema.Set).List()
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+	multipleMetrics := &gcpMultipleMetrics{}
+
+	if v, ok := m[string(elastigroup_gcp_scaling_policies.Metrics)].(*schema.Set); ok {
+		for _, item := range v.List() {
+			attr := item.(map[string]interface{})
+			metric := &gcpMetric{}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.Name)].(string); ok && v != "" {
+				metric.Name = spotinst.String(v)
+			}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.MetricName)].(string); ok && v != "" {
+				metric.MetricName = spotinst.String(v)
+			}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.Namespace)].(string); ok && v != "" {
+				metric.Namespace = spotinst.String(v)
+			}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.ExtendedStatistic)].(string); ok && v != "" {
+				metric.ExtendedStatistic = spotinst.String(v)
+			}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.Statistic)].(string); ok && v != "" {
+				metric.Statistic = spotinst.String(v)
+			}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.Unit)].(string); ok && v != "" {
+				metric.Unit = spotinst.String(v)
+			}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.Dimensions)].([]interface{}); ok {
+				if dimensions := expandGCPScalingDimensions(v); len(dimensions) > 0 {
+					metric.Dimensions = dimensions
+				}
+			}
+
+			if metric.Name != nil && metric.Namespace != nil && metric.MetricName != nil {
+				multipleMetrics.Metrics = append(multipleMetrics.Metrics, metric)
+			}
+		}
+	}
+
+	if v, ok := m[string(elastigroup_gcp_scaling_policies.Expressions)].(*schema.Set); ok {
+		for _, item := range v.List() {
+			attr := item.(map[string]interface{})
+			expression := &gcpExpression{}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.Name)].(string); ok && v != "" {
+				expression.Name = spotinst.String(v)
+			}
+
+			if v, ok := attr[string(elastigroup_gcp_scaling_policies.Expression)].(string); ok && v != "" {
+				expression.Expression = spotinst.String(v)
+			}
+
+			if expression.Name != nil && expression.Expression != nil {
+				multipleMetrics.Expressions = append(multipleMetrics.Expressions, expression)
+			}
+		}
+	}
+
+	if multipleMetrics.Metrics == nil && multipleMetrics.Expressions == nil {
+		return nil
+	}
+	return multipleMetrics
+}
+
+func expandGCPScalingDimensions(list []interface{}) []*gcp.Dimension {
+	dimensions := make([]*gcp.Dimension, 0, len(list))
+	for _, v := range list {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := attr[string(elastigroup_gcp_scaling_policies.DimensionName)].(string)
+		value, _ := attr[string(elastigroup_gcp_scaling_policies.DimensionValue)].(string)
+		dimensions = append(dimensions, &gcp.Dimension{
+			Name:  spotinst.String(name),
+			Value: spotinst.String(value),
+		})
+	}
+	return dimensions
+}
+
+func flattenGCPTargetScalingPolicies(policies []*gcpTargetScalingPolicy) []interface{} {
+	result := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		m := make(map[string]interface{})
+		m[string(elastigroup_gcp_scaling_policies.PolicyName)] = spotinst.StringValue(policy.PolicyName)
+		m[string(elastigroup_gcp_scaling_policies.MetricName)] = spotinst.StringValue(policy.MetricName)
+		m[string(elastigroup_gcp_scaling_policies.Namespace)] = spotinst.StringValue(policy.Namespace)
+		m[string(elastigroup_gcp_scaling_policies.Source)] = spotinst.StringValue(policy.Source)
+		m[string(elastigroup_gcp_scaling_policies.Statistic)] = spotinst.StringValue(policy.Statistic)
+		m[string(elastigroup_gcp_scaling_policies.Unit)] = spotinst.StringValue(policy.Unit)
+		m[string(elastigroup_gcp_scaling_policies.Cooldown)] = spotinst.IntValue(policy.Cooldown)
+		m[string(elastigroup_gcp_scaling_policies.Target)] = spotinst.Float64Value(policy.Target)
+		m[string(elastigroup_gcp_scaling_policies.Period)] = spotinst.IntValue(policy.Period)
+		m[string(elastigroup_gcp_scaling_policies.EvaluationPeriods)] = spotinst.IntValue(policy.EvaluationPeriods)
+		m[string(elastigroup_gcp_scaling_policies.MaxCapacityPerScale)] = spotinst.StringValue(policy.MaxCapacityPerScale)
+
+		if policy.Predictive != nil && policy.Predictive.Mode != nil {
+			m[string(elastigroup_gcp_scaling_policies.PredictiveMode)] = spotinst.StringValue(policy.Predictive.Mode)
+		}
+
+		if len(policy.Dimensions) > 0 {
+			m[string(elastigroup_gcp_scaling_policies.Dimensions)] = flattenGCPScalingDimensions(policy.Dimensions)
+		}
+
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenGCPMultipleMetrics(multipleMetrics *gcpMultipleMetrics) []interface{} {
+	metrics := make([]interface{}, 0, len(multipleMetrics.Metrics))
+	for _, metric := range multipleMetrics.Metrics {
+		m := make(map[string]interface{})
+		m[string(elastigroup_gcp_scaling_policies.Name)] = spotinst.StringValue(metric.Name)
+		m[string(elastigroup_gcp_scaling_policies.MetricName)] = spotinst.StringValue(metric.MetricName)
+		m[string(elastigroup_gcp_scaling_policies.Namespace)] = spotinst.StringValue(metric.Namespace)
+		m[string(elastigroup_gcp_scaling_policies.ExtendedStatistic)] = spotinst.StringValue(metric.ExtendedStatistic)
+		m[string(elastigroup_gcp_scaling_policies.Statistic)] = spotinst.StringValue(metric.Statistic)
+		m[string(elastigroup_gcp_scaling_policies.Unit)] = spotinst.StringValue(metric.Unit)
+
+		if len(metric.Dimensions) > 0 {
+			m[string(elastigroup_gcp_scaling_policies.Dimensions)] = flattenGCPScalingDimensions(metric.Dimensions)
+		}
+
+		metrics = append(metrics, m)
+	}
+
+	expressions := make([]interface{}, 0, len(multipleMetrics.Expressions))
+	for _, expression := range multipleMetrics.Expressions {
+		m := make(map[string]interface{})
+		m[string(elastigroup_gcp_scaling_policies.Name)] = spotinst.StringValue(expression.Name)
+		m[string(elastigroup_gcp_scaling_policies.Expression)] = spotinst.StringValue(expression.Expression)
+		expressions = append(expressions, m)
+	}
+
+	return []interface{}{map[string]interface{}{
+		string(elastigroup_gcp_scaling_policies.Metrics):     metrics,
+		string(elastigroup_gcp_scaling_policies.Expressions): expressions,
+	}}
+}
+
+func flattenGCPScalingDimensions(dimensions []*gcp.Dimension) []interface{} {
+	result := make([]interface{}, 0, len(dimensions))
+	for _, dimension := range dimensions {
+		result = append(result, map[string]interface{}{
+			string(elastigroup_gcp_scaling_policies.DimensionName):  spotinst.StringValue(dimension.Name),
+			string(elastigroup_gcp_scaling_policies.DimensionValue): spotinst.StringValue(dimension.Value),
+		})
+	}
+	return result
+}
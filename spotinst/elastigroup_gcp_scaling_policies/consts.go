@@ -3,8 +3,16 @@ package elastigroup_gcp_scaling_policies
 import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 
 const (
-	ScalingDownPolicy commons.FieldName = "scaling_down_policy"
-	ScalingUpPolicy   commons.FieldName = "scaling_up_policy"
+	ScalingDownPolicy   commons.FieldName = "scaling_down_policy"
+	ScalingUpPolicy     commons.FieldName = "scaling_up_policy"
+	ScalingTargetPolicy commons.FieldName = "scaling_target_policy"
+	MultipleMetrics     commons.FieldName = "multiple_metrics"
+
+	Expressions commons.FieldName = "expressions"
+	Metrics     commons.FieldName = "metrics"
+
+	Expression commons.FieldName = "expression"
+	Name       commons.FieldName = "name"
 
 	Cooldown   commons.FieldName = "cooldown"
 	Dimensions commons.FieldName = "dimensions"
@@ -15,12 +23,16 @@ const (
 	Statistic  commons.FieldName = "statistic"
 	Unit       commons.FieldName = "unit"
 
-	ActionType        commons.FieldName = "action_type"
-	Adjustment        commons.FieldName = "adjustment"
-	EvaluationPeriods commons.FieldName = "evaluation_periods"
-	Operator          commons.FieldName = "operator"
-	Period            commons.FieldName = "period"
-	Threshold         commons.FieldName = "threshold"
+	ActionType          commons.FieldName = "action_type"
+	Adjustment          commons.FieldName = "adjustment"
+	EvaluationPeriods   commons.FieldName = "evaluation_periods"
+	Operator            commons.FieldName = "operator"
+	Period              commons.FieldName = "period"
+	Threshold           commons.FieldName = "threshold"
+	Target              commons.FieldName = "target"
+	PredictiveMode      commons.FieldName = "predictive_mode"
+	MaxCapacityPerScale commons.FieldName = "max_capacity_per_scale"
+	ExtendedStatistic   commons.FieldName = "extended_statistic"
 
 	DimensionName  commons.FieldName = "name"
 	DimensionValue commons.FieldName = "value"
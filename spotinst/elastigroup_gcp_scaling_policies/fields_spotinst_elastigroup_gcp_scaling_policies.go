@@ -1,9 +1,13 @@
 package elastigroup_gcp_scaling_policies
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -102,6 +106,240 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	// The SDK does not model the target policies and the multiple metrics of
+	// GCP groups, so they are read and applied by the resources.
+	fieldsMap[ScalingTargetPolicy] = commons.NewGenericField(
+		commons.ElastigroupGCPScalingPolicies,
+		ScalingTargetPolicy,
+		targetScalingPolicySchema(),
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[MultipleMetrics] = commons.NewGenericField(
+		commons.ElastigroupGCPScalingPolicies,
+		MultipleMetrics,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(Expressions): {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(Expression): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+
+								string(Name): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+
+					string(Metrics): {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(Dimensions): {
+									Type:     schema.TypeList,
+									Optional: true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											string(DimensionName): {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+
+											string(DimensionValue): {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+										},
+									},
+								},
+
+								string(ExtendedStatistic): {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+
+								string(MetricName): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+
+								string(Name): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+
+								string(Namespace): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+
+								string(Statistic): {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+
+								string(Unit): {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[ScalingUpPolicy].AddDiffValidator(validateScalingPolicies)
+	fieldsMap[MultipleMetrics].AddDiffValidator(validateMultipleMetrics)
+}
+
+// validateScalingPolicies validates that dimensions are not repeated within a
+// policy. Policies may otherwise watch the same metric and dimensions, e.g. to
+// scale in steps at increasing thresholds.
+func validateScalingPolicies(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	fields := []commons.FieldName{ScalingUpPolicy, ScalingDownPolicy, ScalingTargetPolicy}
+	if !commons.DiffHasChange(resourceDiff, string(ScalingUpPolicy), string(ScalingDownPolicy), string(ScalingTargetPolicy)) {
+		return nil
+	}
+
+	for _, field := range fields {
+		if !resourceDiff.NewValueKnown(string(field)) {
+			return nil
+		}
+
+		for _, m := range diffSetItems(resourceDiff, string(field)) {
+			if err := validateScalingPolicyDimensions(m); err != nil {
+				policyName, _ := m[string(PolicyName)].(string)
+				return fmt.Errorf("%s: policy %q: %v", field, policyName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// diffSetCodes returns the keys of the planned items of a set of policies or
+// metrics at the given address. The dimensions of items added by the plan are
+// not read back from the set itself, so those items are found using the keys
+// changed by the plan instead.
+func diffSetCodes(resourceDiff *schema.ResourceDiff, address string) []string {
+	var codes []string
+	seen := make(map[string]bool)
+	addCode := func(code string) {
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+
+	prefix := address + "."
+	for _, key := range resourceDiff.GetChangedKeysPrefix(prefix) {
+		if parts := strings.SplitN(strings.TrimPrefix(key, prefix), ".", 2); len(parts) == 2 {
+			addCode(parts[0])
+		}
+	}
+
+	items := resourceDiff.Get(address).(*schema.Set)
+	for _, item := range items.List() {
+		m := item.(map[string]interface{})
+		if dimensions, ok := m[string(Dimensions)].([]interface{}); ok {
+			complete := true
+			for _, d := range dimensions {
+				complete = complete && d != nil
+			}
+			if complete {
+				addCode(strconv.Itoa(items.F(item)))
+			}
+		}
+	}
+	return codes
+}
+
+// diffSetItems returns the planned items of a set of policies or metrics at
+// the given address, each read using its key in the set.
+func diffSetItems(resourceDiff *schema.ResourceDiff, address string) []map[string]interface{} {
+	codes := diffSetCodes(resourceDiff, address)
+	result := make([]map[string]interface{}, 0, len(codes))
+	for _, code := range codes {
+		m, ok := resourceDiff.Get(address + "." + code).(map[string]interface{})
+		if !ok || len(m) == 0 {
+			continue
+		}
+		m[string(Dimensions)] = resourceDiff.Get(fmt.Sprintf("%s.%s.%s", address, code, Dimensions))
+		result = append(result, m)
+	}
+	return result
+}
+
+// validateMultipleMetrics validates that the metrics and the expressions of
+// the multiple metrics have unique names, as expressions refer to them by
+// name, and that dimensions are not repeated within a metric.
+func validateMultipleMetrics(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	if !resourceDiff.HasChange(string(MultipleMetrics)) || !resourceDiff.NewValueKnown(string(MultipleMetrics)) {
+		return nil
+	}
+
+	for _, code := range diffSetCodes(resourceDiff, string(MultipleMetrics)) {
+		address := fmt.Sprintf("%s.%s", MultipleMetrics, code)
+		seen := make(map[string]bool)
+
+		for _, metric := range diffSetItems(resourceDiff, fmt.Sprintf("%s.%s", address, Metrics)) {
+			name, _ := metric[string(Name)].(string)
+			if seen[name] {
+				return fmt.Errorf("%s: name %q is set more than once", MultipleMetrics, name)
+			}
+			seen[name] = true
+
+			if err := validateScalingPolicyDimensions(metric); err != nil {
+				return fmt.Errorf("%s: metric %q: %v", MultipleMetrics, name, err)
+			}
+		}
+
+		if expressions, ok := resourceDiff.Get(fmt.Sprintf("%s.%s", address, Expressions)).(*schema.Set); ok {
+			for _, item := range expressions.List() {
+				name, _ := item.(map[string]interface{})[string(Name)].(string)
+				if seen[name] {
+					return fmt.Errorf("%s: name %q is set more than once", MultipleMetrics, name)
+				}
+				seen[name] = true
+			}
+		}
+	}
+	return nil
+}
+
+// validateScalingPolicyDimensions validates that each dimension of a policy
+// is set once.
+func validateScalingPolicyDimensions(m map[string]interface{}) error {
+	seen := make(map[string]bool)
+	if list, ok := m[string(Dimensions)].([]interface{}); ok {
+		for _, v := range list {
+			attr, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := attr[string(DimensionName)].(string)
+			if seen[name] {
+				return fmt.Errorf("dimension %q is set more than once", name)
+			}
+			seen[name] = true
+		}
+	}
+	return nil
 }
 
 func baseScalingPolicySchema() *schema.Schema {
@@ -210,6 +448,39 @@ func upDownScalingPolicySchema() *schema.Schema {
 	return o
 }
 
+func targetScalingPolicySchema() *schema.Schema {
+	o := baseScalingPolicySchema()
+	s := o.Elem.(*schema.Resource).Schema
+
+	s[string(Target)] = &schema.Schema{
+		Type:     schema.TypeFloat,
+		Required: true,
+	}
+
+	s[string(PredictiveMode)] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"FORECAST_AND_SCALE", "FORECAST_ONLY"}, false),
+	}
+
+	s[string(MaxCapacityPerScale)] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	s[string(Period)] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+	}
+
+	s[string(EvaluationPeriods)] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+	}
+
+	return o
+}
+
 func expandGCPGroupScalingPolicies(data interface{}) ([]*gcp.ScalingPolicy, error) {
 	list := data.(*schema.Set).List()
 	policies := make([]*gcp.ScalingPolicy, 0, len(list))
@@ -0,0 +1,326 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testGCPScalingConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"name": "group",
+		"scaling_target_policy": []interface{}{
+			map[string]interface{}{
+				"policy_name":            "target-1",
+				"metric_name":            "instance/cpu/utilization",
+				"namespace":              "compute",
+				"source":                 "stackdriver",
+				"statistic":              "average",
+				"unit":                   "percent",
+				"cooldown":               300,
+				"target":                 0.6,
+				"predictive_mode":        "FORECAST_AND_SCALE",
+				"max_capacity_per_scale": "5",
+				"dimensions": []interface{}{
+					map[string]interface{}{"name": "zone", "value": "us-central1-a"},
+				},
+			},
+		},
+		"multiple_metrics": []interface{}{
+			map[string]interface{}{
+				"metrics": []interface{}{
+					map[string]interface{}{
+						"name":        "cpu",
+						"metric_name": "instance/cpu/utilization",
+						"namespace":   "compute",
+						"statistic":   "average",
+					},
+				},
+				"expressions": []interface{}{
+					map[string]interface{}{"name": "doubled", "expression": "cpu * 2"},
+				},
+			},
+		},
+	}
+}
+
+func TestElastigroupGCPScaling(t *testing.T) {
+	for name, res := range map[string]*schema.Resource{
+		"gcp": resourceSpotinstElastigroupGCP(),
+		"gke": resourceSpotinstElastigroupGKE(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeAPI(t).Put("/gcp/gce/group/sig-12345678", map[string]interface{}{"id": "sig-12345678", "name": "group"})
+			c, err := gcpGroupClient(api.Client())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, res.Schema, testGCPScalingConfig())
+			resourceData.SetId("sig-12345678")
+
+			if err := onCreateGCPGroupScaling(context.Background(), resourceData, c); err != nil {
+				t.Fatal(err)
+			}
+
+			requests := api.Requests()
+			if len(requests) != 1 || requests[0].Method != http.MethodPut || requests[0].Path != "/gcp/gce/group/sig-12345678" {
+				t.Fatalf("requests: got %+v, want a single PUT", requests)
+			}
+			want := map[string]interface{}{
+				"scaling": map[string]interface{}{
+					"target": []interface{}{
+						map[string]interface{}{
+							"policyName":          "target-1",
+							"metricName":          "instance/cpu/utilization",
+							"namespace":           "compute",
+							"source":              "stackdriver",
+							"statistic":           "average",
+							"unit":                "percent",
+							"cooldown":            float64(300),
+							"target":              0.6,
+							"maxCapacityPerScale": "5",
+							"predictive":          map[string]interface{}{"mode": "FORECAST_AND_SCALE"},
+							"dimensions": []interface{}{
+								map[string]interface{}{"name": "zone", "value": "us-central1-a"},
+							},
+						},
+					},
+					"multipleMetrics": map[string]interface{}{
+						"metrics": []interface{}{
+							map[string]interface{}{
+								"name":       "cpu",
+								"metricName": "instance/cpu/utilization",
+								"namespace":  "compute",
+								"statistic":  "average",
+							},
+						},
+						"expressions": []interface{}{
+							map[string]interface{}{"name": "doubled", "expression": "cpu * 2"},
+						},
+					},
+				},
+			}
+			if got := requests[0].Body["group"]; !reflect.DeepEqual(got, want) {
+				t.Errorf("group: got %v, want %v", got, want)
+			}
+
+			readData := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{"name": "group"})
+			readData.SetId("sig-12345678")
+			if err := onReadGCPGroupScaling(context.Background(), readData, c); err != nil {
+				t.Fatal(err)
+			}
+			targets := readData.Get("scaling_target_policy").(*schema.Set).List()
+			if len(targets) != 1 {
+				t.Fatalf("scaling_target_policy: got %v, want a single policy", targets)
+			}
+			for key, want := range map[string]interface{}{
+				"policy_name":            "target-1",
+				"target":                 0.6,
+				"cooldown":               300,
+				"predictive_mode":        "FORECAST_AND_SCALE",
+				"max_capacity_per_scale": "5",
+				"dimensions": []interface{}{
+					map[string]interface{}{"name": "zone", "value": "us-central1-a"},
+				},
+			} {
+				if got := targets[0].(map[string]interface{})[key]; !reflect.DeepEqual(got, want) {
+					t.Errorf("scaling_target_policy.%s: got %v, want %v", key, got, want)
+				}
+			}
+
+			multipleMetrics := readData.Get("multiple_metrics").(*schema.Set).List()
+			if len(multipleMetrics) != 1 {
+				t.Fatalf("multiple_metrics: got %v, want a single block", multipleMetrics)
+			}
+			m := multipleMetrics[0].(map[string]interface{})
+			metrics := m["metrics"].(*schema.Set).List()
+			if len(metrics) != 1 || metrics[0].(map[string]interface{})["name"] != "cpu" {
+				t.Errorf("multiple_metrics.metrics: got %v, want the cpu metric", metrics)
+			}
+			expressions := m["expressions"].(*schema.Set).List()
+			if len(expressions) != 1 || expressions[0].(map[string]interface{})["expression"] != "cpu * 2" {
+				t.Errorf("multiple_metrics.expressions: got %v, want the doubled expression", expressions)
+			}
+		})
+	}
+}
+
+func TestElastigroupGCPScaling_Clear(t *testing.T) {
+	r := resourceSpotinstElastigroupGCP()
+	api := newFakeAPI(t).Put("/gcp/gce/group/sig-12345678", map[string]interface{}{"id": "sig-12345678"})
+	c, err := gcpGroupClient(api.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := schema.TestResourceDataRaw(t, r.Schema, testGCPScalingConfig())
+	existing.SetId("sig-12345678")
+	state := existing.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "group",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := onUpdateGCPGroupScaling(context.Background(), resourceData, c); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := api.Requests()
+	if len(requests) != 1 || requests[0].Method != http.MethodPut {
+		t.Fatalf("requests: got %+v, want a single PUT", requests)
+	}
+	want := map[string]interface{}{
+		"scaling": map[string]interface{}{"target": nil, "multipleMetrics": nil},
+	}
+	if got := requests[0].Body["group"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("group: got %v, want %v", got, want)
+	}
+}
+
+func TestElastigroupGCPScaling_NotConfigured(t *testing.T) {
+	api := newFakeAPI(t)
+	c, err := gcpGroupClient(api.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupGCP().Schema, map[string]interface{}{"name": "group"})
+	resourceData.SetId("sig-12345678")
+
+	if err := onCreateGCPGroupScaling(context.Background(), resourceData, c); err != nil {
+		t.Fatal(err)
+	}
+	if err := onUpdateGCPGroupScaling(context.Background(), resourceData, c); err != nil {
+		t.Fatal(err)
+	}
+	if requests := api.Requests(); len(requests) != 0 {
+		t.Errorf("requests: got %+v, want none", requests)
+	}
+}
+
+func TestElastigroupGCPScaling_Validation(t *testing.T) {
+	r := resourceSpotinstElastigroupGKE()
+
+	metric := func(name string, dimensions ...string) map[string]interface{} {
+		dims := make([]interface{}, 0, len(dimensions))
+		for _, d := range dimensions {
+			dims = append(dims, map[string]interface{}{"name": d, "value": "value-1"})
+		}
+		return map[string]interface{}{
+			"name":        name,
+			"metric_name": "instance/cpu/utilization",
+			"namespace":   "compute",
+			"dimensions":  dims,
+		}
+	}
+	expression := func(name string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "expression": "cpu * 2"}
+	}
+	target := func(dimensions ...string) map[string]interface{} {
+		m := metric("", dimensions...)
+		delete(m, "name")
+		m["policy_name"] = "target-1"
+		m["unit"] = "percent"
+		m["target"] = 0.6
+		return m
+	}
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		want   string
+	}{
+		{
+			name: "distinct names",
+			config: map[string]interface{}{
+				"multiple_metrics": []interface{}{map[string]interface{}{
+					"metrics":     []interface{}{metric("cpu", "zone"), metric("memory", "zone")},
+					"expressions": []interface{}{expression("doubled")},
+				}},
+			},
+		},
+		{
+			name: "repeated metric name",
+			config: map[string]interface{}{
+				"multiple_metrics": []interface{}{map[string]interface{}{
+					"metrics": []interface{}{metric("cpu", "zone"), metric("cpu", "region")},
+				}},
+			},
+			want: `name "cpu" is set more than once`,
+		},
+		{
+			name: "expression named as a metric",
+			config: map[string]interface{}{
+				"multiple_metrics": []interface{}{map[string]interface{}{
+					"metrics":     []interface{}{metric("cpu")},
+					"expressions": []interface{}{expression("cpu")},
+				}},
+			},
+			want: `name "cpu" is set more than once`,
+		},
+		{
+			name: "repeated metric dimension",
+			config: map[string]interface{}{
+				"multiple_metrics": []interface{}{map[string]interface{}{
+					"metrics": []interface{}{metric("cpu", "zone", "zone")},
+				}},
+			},
+			want: `dimension "zone" is set more than once`,
+		},
+		{
+			name:   "target policy",
+			config: map[string]interface{}{"scaling_target_policy": []interface{}{target("zone")}},
+		},
+		{
+			name:   "repeated target policy dimension",
+			config: map[string]interface{}{"scaling_target_policy": []interface{}{target("zone", "zone")}},
+			want:   `dimension "zone" is set more than once`,
+		},
+		{
+			name: "unknown predictive mode",
+			config: map[string]interface{}{"scaling_target_policy": []interface{}{
+				func() map[string]interface{} { m := target(); m["predictive_mode"] = "SCALE"; return m }(),
+			}},
+			want: "predictive_mode",
+		},
+	}
+	for _, c := range cases {
+		c.config["name"] = "group"
+		c.config["cluster_zone_name"] = "us-central1-a"
+		c.config["desired_capacity"] = 1
+
+		var errs []string
+		for _, d := range r.Validate(terraform.NewResourceConfigRaw(c.config)) {
+			if d.Severity == diag.Error {
+				errs = append(errs, d.Summary)
+			}
+		}
+		if len(errs) == 0 {
+			if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(c.config), nil); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+		err := strings.Join(errs, "\n")
+
+		if c.want == "" && err != "" {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if c.want != "" && !strings.Contains(err, c.want) {
+			t.Errorf("%s: got %q, want an error containing %q", c.name, err, c.want)
+		}
+	}
+}
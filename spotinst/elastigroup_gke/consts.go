@@ -17,4 +17,7 @@ const (
 	ClusterZoneName commons.FieldName = "cluster_zone_name"
 	ClusterID       commons.FieldName = "cluster_id"
 	// -----------------------------------
+
+	WaitForCapacity        commons.FieldName = "wait_for_capacity"
+	WaitForCapacityTimeout commons.FieldName = "wait_for_capacity_timeout"
 )
@@ -1,6 +1,7 @@
 package elastigroup_gke
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -264,4 +265,33 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[WaitForCapacity] = commons.NewGenericField(
+		commons.ElastigroupGKE,
+		WaitForCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCapacityTimeout] = commons.NewGenericField(
+		commons.ElastigroupGKE,
+		WaitForCapacityTimeout,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCapacity].AddDiffValidator(validateWaitForCapacity)
+}
+
+func validateWaitForCapacity(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	if err := commons.ValidateDiffRequiredTogether(resourceDiff,
+		string(WaitForCapacity), string(WaitForCapacityTimeout)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffLessOrEqual(resourceDiff, string(WaitForCapacity), string(TargetCapacity))
 }
@@ -0,0 +1,329 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_update_policy"
+)
+
+// The SDK does not expose the roll operations of GCP and Azure Elastigroups,
+// so their deployments are started and tracked directly using the underlying
+// API client of the group service.
+
+// elastigroupRollEndpoint describes how a deployment of a group is started:
+// the path template of the roll, and the HTTP method of the request. The
+// status of a deployment is read from the same path, followed by its ID.
+type elastigroupRollEndpoint struct {
+	path   string
+	method string
+}
+
+var (
+	// Roll Group and Get Deployment Status of the Elastigroup GCP API, see
+	// https://docs.spot.io/api/. They match the AWS roll of the SDK, which
+	// uses PUT /aws/ec2/group/{groupId}/roll and GET .../roll/{rollId}.
+	gcpGroupRollEndpoint = elastigroupRollEndpoint{
+		path:   "/gcp/gce/group/{groupId}/roll",
+		method: http.MethodPut,
+	}
+
+	// Roll Group and Get Deployment Status of the Elastigroup Azure API, see
+	// https://docs.spot.io/api/. Unlike GCP and AWS, the roll is started with
+	// a POST request.
+	azureV3GroupRollEndpoint = elastigroupRollEndpoint{
+		path:   "/azure/compute/group/{groupId}/roll",
+		method: http.MethodPost,
+	}
+)
+
+// elastigroupRollInput is the body of a GCP or Azure Elastigroup roll request.
+type elastigroupRollInput struct {
+	BatchSizePercentage *int    `json:"batchSizePercentage,omitempty"`
+	GracePeriod         *int    `json:"gracePeriod,omitempty"`
+	HealthCheckType     *string `json:"healthCheckType,omitempty"`
+}
+
+// elastigroupRollStatus is the status of a GCP or Azure Elastigroup deployment.
+// It has the shape of aws.RollGroupStatus of the SDK, whose progress value is
+// the percentage of the deployment that is complete.
+type elastigroupRollStatus struct {
+	RollID     *string `json:"id,omitempty"`
+	RollStatus *string `json:"status,omitempty"`
+	Progress   *struct {
+		Unit  *string  `json:"unit,omitempty"`
+		Value *float64 `json:"value,omitempty"`
+	} `json:"progress,omitempty"`
+}
+
+// elastigroupRollStatusReader fetches the current status of a deployment.
+type elastigroupRollStatusReader func(ctx context.Context) ([]*elastigroupRollStatus, error)
+
+// awaitElastigroupRoll polls the status of a deployment until it reaches
+// pctComplete, or fails with an error if the deployment failed, was stopped,
+// or did not progress enough within timeout seconds.
+func awaitElastigroupRoll(ctx context.Context, groupID, rollID string, pctComplete float64, timeout int, read elastigroupRollStatusReader) error {
+	log.Printf("awaitElastigroupRoll() Waiting for deployment of group: %s", groupID)
+
+	if rollID == "" {
+		return fmt.Errorf("invalid roll id: %s", rollID)
+	}
+
+	var rollFailure error
+	err := resource.RetryContext(ctx, time.Second*time.Duration(timeout), func() *resource.RetryError {
+		statuses, err := read(ctx)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("call to roll status of group %q failed: %v", groupID, err))
+		}
+		if len(statuses) == 0 {
+			return resource.NonRetryableError(fmt.Errorf("roll %q of group %q not found", rollID, groupID))
+		}
+
+		status := statuses[0]
+		var progress float64
+		if status.Progress != nil {
+			progress = spotinst.Float64Value(status.Progress.Value)
+		}
+
+		// STARTING and IN_PROGRESS are the statuses of a running deployment
+		// handled by awaitReadyRoll, and STOPPED the one set by the SDK to stop
+		// it. The SDK does not list the other terminal statuses, so FAILED,
+		// FINISHED and COMPLETED only end the wait early, and the progress
+		// decides otherwise.
+		switch rollStatus := strings.ToUpper(spotinst.StringValue(status.RollStatus)); rollStatus {
+		case "FAILED", "STOPPED":
+			rollFailure = fmt.Errorf("roll %q of group %q is %s (%.0f%% complete)",
+				rollID, groupID, strings.ToLower(rollStatus), progress)
+			return resource.NonRetryableError(rollFailure)
+		case "FINISHED", "COMPLETED":
+			return nil
+		}
+
+		if progress < pctComplete {
+			log.Printf("awaitElastigroupRoll() Waiting for at least %f%% of batches to complete, current status: %f%%",
+				pctComplete, progress)
+
+			return resource.RetryableError(fmt.Errorf("roll at %v%% complete", progress))
+		}
+
+		return nil
+	})
+	if rollFailure != nil {
+		return rollFailure
+	}
+	if err != nil {
+		return fmt.Errorf("did not reach target deployment amount: %v", err)
+	}
+
+	log.Printf("awaitElastigroupRoll() Target deployment percentage reached for group: %s", groupID)
+	return nil
+}
+
+// getElastigroupRollID returns the ID of the deployment that is in progress.
+func getElastigroupRollID(statuses []*elastigroupRollStatus) string {
+	for _, status := range statuses {
+		rs := strings.ToUpper(spotinst.StringValue(status.RollStatus))
+		if rs == "IN_PROGRESS" || rs == "STARTING" {
+			return spotinst.StringValue(status.RollID)
+		}
+	}
+	return ""
+}
+
+// isRollCapacityBelowMinimum reports whether a roll was rejected because it
+// would take the group below its minimum capacity, which is worth retrying.
+func isRollCapacityBelowMinimum(err error) bool {
+	if errs, ok := err.(client.Errors); ok {
+		for _, err := range errs {
+			if strings.Contains(err.Code, "CANT_ROLL_CAPACITY_BELOW_MINIMUM") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func doElastigroupRollRequest(ctx context.Context, c *client.Client, r *client.Request) ([]*elastigroupRollStatus, error) {
+	resp, err := client.RequireOK(c.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var rw client.Response
+	if err := json.Unmarshal(body, &rw); err != nil {
+		return nil, err
+	}
+
+	out := make([]*elastigroupRollStatus, len(rw.Response.Items))
+	for i, item := range rw.Response.Items {
+		out[i] = new(elastigroupRollStatus)
+		if err := json.Unmarshal(item, out[i]); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// shouldRollElastigroup reports whether the update policy of a GCP, GKE or
+// Azure group asks to roll the group after an update.
+func shouldRollElastigroup(resourceData *schema.ResourceData) bool {
+	if updatePolicy, exists := resourceData.GetOkExists(string(elastigroup_update_policy.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+			if roll, ok := m[string(elastigroup_update_policy.ShouldRoll)].(bool); ok {
+				return roll
+			}
+		}
+	}
+	return false
+}
+
+// rollGCPGroup starts a blue/green deployment of a GCP or GKE group.
+func rollGCPGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	c, err := gcpGroupClient(meta)
+	if err != nil {
+		return err
+	}
+	return rollElastigroup(ctx, resourceData, c, gcpGroupRollEndpoint)
+}
+
+// rollAzureV3Group starts a deployment of an Azure group.
+func rollAzureV3Group(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	c, err := azureV3GroupClient(meta)
+	if err != nil {
+		return err
+	}
+	return rollElastigroup(ctx, resourceData, c, azureV3GroupRollEndpoint)
+}
+
+// rollElastigroup starts a deployment of a group with the configured
+// roll_config, and waits for it to reach wait_for_roll_percentage when set.
+func rollElastigroup(ctx context.Context, resourceData *schema.ResourceData, c *client.Client, endpoint elastigroupRollEndpoint) error {
+	groupID := resourceData.Id()
+
+	list := resourceData.Get(string(elastigroup_update_policy.UpdatePolicy)).([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Missing update policy for group [%v]", groupID)
+	}
+
+	rollConfig, ok := list[0].(map[string]interface{})[string(elastigroup_update_policy.RollConfig)]
+	if !ok || rollConfig == nil || len(rollConfig.([]interface{})) == 0 {
+		return fmt.Errorf("[ERROR] onRoll() -> Field [%v] is missing, skipping roll for group [%v]",
+			string(elastigroup_update_policy.RollConfig), groupID)
+	}
+
+	rollGroupInput := expandElastigroupRollInput(rollConfig)
+
+	json, err := commons.ToJson(rollConfig)
+	if err != nil {
+		return fmt.Errorf("[ERROR] onRoll() -> Failed marshaling roll configuration for group [%v], error: %v", groupID, err)
+	}
+	log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupID, json)
+
+	pctComplete, pctTimeout := getOceanRollWaitConfig(rollConfig,
+		elastigroup_update_policy.WaitForRollPct, elastigroup_update_policy.WaitForRollTimeout)
+
+	retryTimeout := time.Duration(pctTimeout) * time.Second
+	if retryTimeout == 0 {
+		retryTimeout = resourceData.Timeout(schema.TimeoutUpdate)
+	}
+
+	return resource.RetryContext(ctx, retryTimeout, func() *resource.RetryError {
+		rollOut, err := endpoint.start(ctx, c, groupID, rollGroupInput)
+		if err != nil {
+			if isRollCapacityBelowMinimum(err) {
+				timer := time.NewTimer(time.Minute)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return resource.NonRetryableError(ctx.Err())
+				case <-timer.C:
+				}
+				return resource.RetryableError(err)
+			}
+
+			// Some other error, report it.
+			return resource.NonRetryableError(err)
+		}
+
+		if pctTimeout > 0 && pctComplete > 0 {
+			// Wait for the roll completion.
+			rollID := getElastigroupRollID(rollOut)
+			if err := awaitElastigroupRoll(ctx, groupID, rollID, pctComplete, pctTimeout,
+				endpoint.read(c, groupID, rollID)); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("[ERROR] Timed out when waiting for minimum roll percentage: %v", err))
+			}
+		}
+
+		log.Printf("onRoll() -> Successfully rolled group [%v]", groupID)
+		return nil
+	})
+}
+
+func expandElastigroupRollInput(data interface{}) *elastigroupRollInput {
+	i := &elastigroupRollInput{}
+	list := data.([]interface{})
+	if len(list) > 0 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(elastigroup_update_policy.BatchSizePercentage)].(int); ok { // Required value
+			i.BatchSizePercentage = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(elastigroup_update_policy.GracePeriod)].(int); ok && v != -1 { // Default value set to -1
+			i.GracePeriod = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(elastigroup_update_policy.HealthCheckType)].(string); ok && v != "" { // Default value ""
+			i.HealthCheckType = spotinst.String(v)
+		}
+	}
+	return i
+}
+
+func (e elastigroupRollEndpoint) start(ctx context.Context, c *client.Client, groupID string, input *elastigroupRollInput) ([]*elastigroupRollStatus, error) {
+	path, err := uritemplates.Expand(e.path, uritemplates.Values{
+		"groupId": groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := client.NewRequest(e.method, path)
+	r.Obj = input
+
+	return doElastigroupRollRequest(ctx, c, r)
+}
+
+func (e elastigroupRollEndpoint) read(c *client.Client, groupID, rollID string) elastigroupRollStatusReader {
+	return func(ctx context.Context) ([]*elastigroupRollStatus, error) {
+		path, err := uritemplates.Expand(e.path+"/{rollId}", uritemplates.Values{
+			"groupId": groupID,
+			"rollId":  rollID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return doElastigroupRollRequest(ctx, c, client.NewRequest(http.MethodGet, path))
+	}
+}
@@ -0,0 +1,116 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+)
+
+func TestRollGCPGroup(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodPut, "/gcp/gce/group/sig-12345678/roll",
+			map[string]interface{}{"id": "sbgd-12345678", "status": "STARTING"}).
+		Respond(http.MethodGet, "/gcp/gce/group/sig-12345678/roll/sbgd-12345678",
+			map[string]interface{}{"id": "sbgd-12345678", "status": "IN_PROGRESS", "progress": map[string]interface{}{"unit": "percent", "value": 50}})
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupGCP().Schema, map[string]interface{}{
+		"update_policy": []interface{}{
+			map[string]interface{}{
+				"should_roll": true,
+				"roll_config": []interface{}{
+					map[string]interface{}{
+						"batch_size_percentage":    33,
+						"health_check_type":        "INSTANCE_STATE",
+						"wait_for_roll_percentage": 50.0,
+						"wait_for_roll_timeout":    60,
+					},
+				},
+			},
+		},
+	})
+	resourceData.SetId("sig-12345678")
+
+	if !shouldRollElastigroup(resourceData) {
+		t.Fatalf("expected the group to be rolled")
+	}
+	if err := rollGCPGroup(context.Background(), resourceData, api.Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := api.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("requests: got %d, want 2", len(requests))
+	}
+	roll := requests[0]
+	if roll.Method != http.MethodPut || roll.Path != "/gcp/gce/group/sig-12345678/roll" {
+		t.Fatalf("roll request: got %s %s", roll.Method, roll.Path)
+	}
+	if got := roll.Body["batchSizePercentage"]; got != 33.0 {
+		t.Errorf("batchSizePercentage: got %v, want 33", got)
+	}
+	if got := roll.Body["healthCheckType"]; got != "INSTANCE_STATE" {
+		t.Errorf("healthCheckType: got %v, want INSTANCE_STATE", got)
+	}
+	if _, ok := roll.Body["gracePeriod"]; ok {
+		t.Errorf("gracePeriod: expected to be omitted when unset")
+	}
+}
+
+func TestRollAzureV3Group(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodPost, "/azure/compute/group/sig-12345678/roll",
+			map[string]interface{}{"id": "sbgd-12345678", "status": "IN_PROGRESS"}).
+		Respond(http.MethodGet, "/azure/compute/group/sig-12345678/roll/sbgd-12345678",
+			map[string]interface{}{"id": "sbgd-12345678", "status": "FINISHED", "progress": map[string]interface{}{"unit": "percent", "value": 100}})
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupAzureV3().Schema, map[string]interface{}{
+		"update_policy": []interface{}{
+			map[string]interface{}{
+				"should_roll": true,
+				"roll_config": []interface{}{
+					map[string]interface{}{
+						"batch_size_percentage":    50,
+						"grace_period":             600,
+						"wait_for_roll_percentage": 100.0,
+						"wait_for_roll_timeout":    60,
+					},
+				},
+			},
+		},
+	})
+	resourceData.SetId("sig-12345678")
+
+	if !shouldRollElastigroup(resourceData) {
+		t.Fatalf("expected the group to be rolled")
+	}
+	if err := rollAzureV3Group(context.Background(), resourceData, api.Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := api.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("requests: got %d, want 2", len(requests))
+	}
+	if got := requests[0].Body["gracePeriod"]; got != 600.0 {
+		t.Errorf("gracePeriod: got %v, want 600", got)
+	}
+	if got := requests[1].Path; got != "/azure/compute/group/sig-12345678/roll/sbgd-12345678" {
+		t.Errorf("roll status request: got %s", got)
+	}
+}
+
+func TestAwaitElastigroupRoll_Failed(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/gcp/gce/group/sig-12345678/roll/sbgd-12345678",
+			map[string]interface{}{"id": "sbgd-12345678", "status": "FAILED"})
+
+	svc := api.Client().elastigroup.CloudProviderGCP().(*gcp.ServiceOp)
+	err := awaitElastigroupRoll(context.Background(), "sig-12345678", "sbgd-12345678", 100, 60,
+		gcpGroupRollEndpoint.read(svc.Client, "sig-12345678", "sbgd-12345678"))
+	if err == nil {
+		t.Fatalf("expected an error for a failed roll")
+	}
+}
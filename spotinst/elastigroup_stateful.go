@@ -0,0 +1,312 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_stateful"
+)
+
+// The SDK does not expose the stateful settings and instances of GCP and
+// Azure Elastigroups, so they are managed directly using the underlying API
+// client of the group service. The requests have the shape of the ones of the
+// AWS groups in the SDK: the persistence is part of the group strategy, the
+// deallocation is the body of the group delete, and the instance actions are
+// PUT requests on .../statefulInstance/{statefulInstanceId}/{action}.
+
+// elastigroupStatefulEndpoint describes where the stateful settings and
+// instances of a group are managed, using the path template of the group.
+type elastigroupStatefulEndpoint struct {
+	path string
+}
+
+var (
+	gcpGroupStatefulEndpoint = elastigroupStatefulEndpoint{
+		path: "/gcp/gce/group/{groupId}",
+	}
+
+	azureV3GroupStatefulEndpoint = elastigroupStatefulEndpoint{
+		path: "/azure/compute/group/{groupId}",
+	}
+)
+
+// elastigroupPersistence is the persistence of a GCP or Azure Elastigroup. It
+// has the shape of aws.Persistence of the SDK.
+type elastigroupPersistence struct {
+	ShouldPersistRootDevice   *bool `json:"shouldPersistRootDevice,omitempty"`
+	ShouldPersistBlockDevices *bool `json:"shouldPersistBlockDevices,omitempty"`
+	ShouldPersistPrivateIP    *bool `json:"shouldPersistPrivateIp,omitempty"`
+}
+
+// elastigroupStatefulDeallocation is the deallocation of the resources of a
+// GCP or Azure Elastigroup on delete. It has the shape of
+// aws.StatefulDeallocation of the SDK.
+type elastigroupStatefulDeallocation struct {
+	ShouldDeleteImages            *bool `json:"shouldDeleteImages,omitempty"`
+	ShouldDeleteNetworkInterfaces *bool `json:"shouldDeleteNetworkInterfaces,omitempty"`
+	ShouldDeleteVolumes           *bool `json:"shouldDeleteVolumes,omitempty"`
+	ShouldDeleteSnapshots         *bool `json:"shouldDeleteSnapshots,omitempty"`
+}
+
+// elastigroupStatefulGroup is the part of a GCP or Azure Elastigroup holding
+// its persistence.
+type elastigroupStatefulGroup struct {
+	Strategy *elastigroupStatefulStrategy `json:"strategy,omitempty"`
+}
+
+type elastigroupStatefulStrategy struct {
+	Persistence *elastigroupPersistence `json:"persistence,omitempty"`
+}
+
+// gcpGroupClient returns the API client of the GCP group service.
+func gcpGroupClient(meta interface{}) (*client.Client, error) {
+	svc, ok := meta.(*Client).elastigroup.CloudProviderGCP().(*gcp.ServiceOp)
+	if !ok {
+		return nil, fmt.Errorf("unsupported elastigroup/gcp service implementation")
+	}
+	return svc.Client, nil
+}
+
+// azureV3GroupClient returns the API client of the Azure group service.
+func azureV3GroupClient(meta interface{}) (*client.Client, error) {
+	svc, ok := meta.(*Client).elastigroup.CloudProviderAzureV3().(*v3.ServiceOp)
+	if !ok {
+		return nil, fmt.Errorf("unsupported elastigroup/azure/v3 service implementation")
+	}
+	return svc.Client, nil
+}
+
+// onCreate sets the persistence of a created group, when any is configured.
+func (e elastigroupStatefulEndpoint) onCreate(ctx context.Context, resourceData *schema.ResourceData, c *client.Client) error {
+	persistence := expandElastigroupPersistence(resourceData)
+	if !spotinst.BoolValue(persistence.ShouldPersistRootDevice) &&
+		!spotinst.BoolValue(persistence.ShouldPersistBlockDevices) &&
+		!spotinst.BoolValue(persistence.ShouldPersistPrivateIP) {
+		return nil
+	}
+	return e.updatePersistence(ctx, c, resourceData.Id(), persistence)
+}
+
+// onRead reads the persistence of a group into the resource.
+func (e elastigroupStatefulEndpoint) onRead(ctx context.Context, resourceData *schema.ResourceData, c *client.Client) error {
+	persistence, err := e.readPersistence(ctx, c, resourceData.Id())
+	if err != nil {
+		return fmt.Errorf("failed to read persistence of group [%v]: %v", resourceData.Id(), err)
+	}
+
+	fields := map[commons.FieldName]*bool{
+		elastigroup_stateful.PersistRootDevice:   persistence.ShouldPersistRootDevice,
+		elastigroup_stateful.PersistBlockDevices: persistence.ShouldPersistBlockDevices,
+		elastigroup_stateful.PersistPrivateIp:    persistence.ShouldPersistPrivateIP,
+	}
+	for field, value := range fields {
+		if err := resourceData.Set(string(field), spotinst.BoolValue(value)); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), string(field), err)
+		}
+	}
+	return nil
+}
+
+// onUpdate updates the persistence of a group, and runs the instance actions,
+// when they changed.
+func (e elastigroupStatefulEndpoint) onUpdate(ctx context.Context, resourceData *schema.ResourceData, c *client.Client) error {
+	groupID := resourceData.Id()
+
+	if resourceData.HasChanges(
+		string(elastigroup_stateful.PersistRootDevice),
+		string(elastigroup_stateful.PersistBlockDevices),
+		string(elastigroup_stateful.PersistPrivateIp)) {
+		if err := e.updatePersistence(ctx, c, groupID, expandElastigroupPersistence(resourceData)); err != nil {
+			return fmt.Errorf("[ERROR] Failed to update persistence of group [%v]: %v", groupID, err)
+		}
+	}
+
+	if resourceData.HasChange(string(elastigroup_stateful.StatefulInstanceAction)) {
+		actionList := resourceData.Get(string(elastigroup_stateful.StatefulInstanceAction)).([]interface{})
+		if err := checkStatefulActionUniqueness(actionList); err != nil {
+			log.Printf("[ERROR] Uniqueness check failed with error: %v", err)
+			return err
+		}
+
+		for _, action := range actionList {
+			var (
+				actionMap  = action.(map[string]interface{})
+				actionType = actionMap[string(elastigroup_stateful.ActionType)].(string)
+				instanceID = actionMap[string(elastigroup_stateful.StatefulInstanceID)].(string)
+			)
+			if err := e.runInstanceAction(ctx, c, groupID, instanceID, actionType); err != nil {
+				log.Printf("[ERROR] Stateful instance (%s) action failed with error: %v", instanceID, err)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// onDelete deletes a group, deallocating its stateful resources as
+// configured.
+func (e elastigroupStatefulEndpoint) onDelete(ctx context.Context, resourceData *schema.ResourceData, c *client.Client) error {
+	path, err := uritemplates.Expand(e.path, uritemplates.Values{
+		"groupId": resourceData.Id(),
+	})
+	if err != nil {
+		return err
+	}
+
+	r := client.NewRequest(http.MethodDelete, path)
+	if deallocation := expandElastigroupStatefulDeallocation(resourceData); deallocation != nil {
+		r.Obj = struct {
+			StatefulDeallocation *elastigroupStatefulDeallocation `json:"statefulDeallocation"`
+		}{deallocation}
+	}
+
+	_, err = doElastigroupRequest(ctx, c, r)
+	return err
+}
+
+func (e elastigroupStatefulEndpoint) updatePersistence(ctx context.Context, c *client.Client, groupID string, persistence *elastigroupPersistence) error {
+	path, err := uritemplates.Expand(e.path, uritemplates.Values{
+		"groupId": groupID,
+	})
+	if err != nil {
+		return err
+	}
+
+	r := client.NewRequest(http.MethodPut, path)
+	r.Obj = struct {
+		Group *elastigroupStatefulGroup `json:"group"`
+	}{&elastigroupStatefulGroup{
+		Strategy: &elastigroupStatefulStrategy{Persistence: persistence},
+	}}
+
+	if json, err := commons.ToJson(persistence); err == nil {
+		log.Printf("===> Group [%v] persistence configuration: %s", groupID, json)
+	}
+
+	_, err = doElastigroupRequest(ctx, c, r)
+	return err
+}
+
+func (e elastigroupStatefulEndpoint) readPersistence(ctx context.Context, c *client.Client, groupID string) (*elastigroupPersistence, error) {
+	path, err := uritemplates.Expand(e.path, uritemplates.Values{
+		"groupId": groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := doElastigroupRequest(ctx, c, client.NewRequest(http.MethodGet, path))
+	if err != nil {
+		return nil, err
+	}
+
+	persistence := &elastigroupPersistence{}
+	if len(items) > 0 {
+		group := &elastigroupStatefulGroup{}
+		if err := json.Unmarshal(items[0], group); err != nil {
+			return nil, err
+		}
+		if group.Strategy != nil && group.Strategy.Persistence != nil {
+			persistence = group.Strategy.Persistence
+		}
+	}
+	return persistence, nil
+}
+
+func (e elastigroupStatefulEndpoint) runInstanceAction(ctx context.Context, c *client.Client, groupID, instanceID, actionType string) error {
+	action := strings.ToLower(actionType)
+	switch action {
+	case "pause", "resume", "recycle", "deallocate":
+	default:
+		return fmt.Errorf("unsupported action %q on instance %q", actionType, instanceID)
+	}
+
+	path, err := uritemplates.Expand(e.path+"/statefulInstance/{statefulInstanceId}/{action}", uritemplates.Values{
+		"groupId":            groupID,
+		"statefulInstanceId": instanceID,
+		"action":             action,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Running %s on instance (%s)", action, instanceID)
+	if _, err := doElastigroupRequest(ctx, c, client.NewRequest(http.MethodPut, path)); err != nil {
+		return fmt.Errorf("failed to %s instance (%s): %v", action, instanceID, err)
+	}
+
+	log.Printf("Successfully ran %s on instance (%s)", action, instanceID)
+	return nil
+}
+
+func doElastigroupRequest(ctx context.Context, c *client.Client, r *client.Request) ([]json.RawMessage, error) {
+	resp, err := client.RequireOK(c.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	var rw client.Response
+	if err := json.Unmarshal(body, &rw); err != nil {
+		return nil, err
+	}
+	return rw.Response.Items, nil
+}
+
+func expandElastigroupPersistence(resourceData *schema.ResourceData) *elastigroupPersistence {
+	persistence := &elastigroupPersistence{}
+	if v, ok := resourceData.Get(string(elastigroup_stateful.PersistRootDevice)).(bool); ok {
+		persistence.ShouldPersistRootDevice = spotinst.Bool(v)
+	}
+	if v, ok := resourceData.Get(string(elastigroup_stateful.PersistBlockDevices)).(bool); ok {
+		persistence.ShouldPersistBlockDevices = spotinst.Bool(v)
+	}
+	if v, ok := resourceData.Get(string(elastigroup_stateful.PersistPrivateIp)).(bool); ok {
+		persistence.ShouldPersistPrivateIP = spotinst.Bool(v)
+	}
+	return persistence
+}
+
+func expandElastigroupStatefulDeallocation(resourceData *schema.ResourceData) *elastigroupStatefulDeallocation {
+	list, _ := resourceData.Get(string(elastigroup_stateful.StatefulDeallocation)).([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+
+	result := &elastigroupStatefulDeallocation{}
+	if v, ok := m[string(elastigroup_stateful.ShouldDeleteImages)].(bool); ok && v {
+		result.ShouldDeleteImages = spotinst.Bool(v)
+	}
+	if v, ok := m[string(elastigroup_stateful.ShouldDeleteNetworkInterfaces)].(bool); ok && v {
+		result.ShouldDeleteNetworkInterfaces = spotinst.Bool(v)
+	}
+	if v, ok := m[string(elastigroup_stateful.ShouldDeleteVolumes)].(bool); ok && v {
+		result.ShouldDeleteVolumes = spotinst.Bool(v)
+	}
+	if v, ok := m[string(elastigroup_stateful.ShouldDeleteSnapshots)].(bool); ok && v {
+		result.ShouldDeleteSnapshots = spotinst.Bool(v)
+	}
+	return result
+}
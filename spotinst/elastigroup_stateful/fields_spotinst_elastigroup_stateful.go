@@ -0,0 +1,102 @@
+package elastigroup_stateful
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// Setup registers the stateful fields of the GCP and Azure Elastigroups. The
+// SDK does not model them, so they are read and applied by the resources.
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[PersistRootDevice] = commons.NewGenericField(
+		commons.ElastigroupStateful,
+		PersistRootDevice,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[PersistBlockDevices] = commons.NewGenericField(
+		commons.ElastigroupStateful,
+		PersistBlockDevices,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[PersistPrivateIp] = commons.NewGenericField(
+		commons.ElastigroupStateful,
+		PersistPrivateIp,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[StatefulDeallocation] = commons.NewGenericField(
+		commons.ElastigroupStateful,
+		StatefulDeallocation,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldDeleteImages): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
+					string(ShouldDeleteNetworkInterfaces): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
+					string(ShouldDeleteVolumes): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
+					string(ShouldDeleteSnapshots): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[StatefulInstanceAction] = commons.NewGenericField(
+		commons.ElastigroupStateful,
+		StatefulInstanceAction,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(StatefulInstanceID): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(ActionType): {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice(
+							[]string{"pause", "resume", "recycle", "deallocate"}, true),
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+}
@@ -0,0 +1,203 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+func TestElastigroupStatefulPersistence(t *testing.T) {
+	cases := map[string]struct {
+		res      *schema.Resource
+		endpoint elastigroupStatefulEndpoint
+		client   func(meta interface{}) (*client.Client, error)
+		path     string
+	}{
+		"gcp": {
+			res:      resourceSpotinstElastigroupGCP(),
+			endpoint: gcpGroupStatefulEndpoint,
+			client:   gcpGroupClient,
+			path:     "/gcp/gce/group/sig-12345678",
+		},
+		"azure_v3": {
+			res:      resourceSpotinstElastigroupAzureV3(),
+			endpoint: azureV3GroupStatefulEndpoint,
+			client:   azureV3GroupClient,
+			path:     "/azure/compute/group/sig-12345678",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeAPI(t).Put(tc.path, map[string]interface{}{"id": "sig-12345678", "name": "group"})
+			c, err := tc.client(api.Client())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resourceData := schema.TestResourceDataRaw(t, tc.res.Schema, map[string]interface{}{
+				"persist_root_device": true,
+				"persist_private_ip":  true,
+			})
+			resourceData.SetId("sig-12345678")
+
+			if err := tc.endpoint.onCreate(context.Background(), resourceData, c); err != nil {
+				t.Fatal(err)
+			}
+
+			requests := api.Requests()
+			if len(requests) != 1 || requests[0].Method != http.MethodPut || requests[0].Path != tc.path {
+				t.Fatalf("requests: got %+v, want a single PUT %s", requests, tc.path)
+			}
+			want := map[string]interface{}{
+				"strategy": map[string]interface{}{
+					"persistence": map[string]interface{}{
+						"shouldPersistRootDevice":   true,
+						"shouldPersistBlockDevices": false,
+						"shouldPersistPrivateIp":    true,
+					},
+				},
+			}
+			if got := requests[0].Body["group"]; !reflect.DeepEqual(got, want) {
+				t.Errorf("group: got %v, want %v", got, want)
+			}
+
+			readData := schema.TestResourceDataRaw(t, tc.res.Schema, map[string]interface{}{})
+			readData.SetId("sig-12345678")
+			if err := tc.endpoint.onRead(context.Background(), readData, c); err != nil {
+				t.Fatal(err)
+			}
+			for field, want := range map[string]bool{
+				"persist_root_device":   true,
+				"persist_block_devices": false,
+				"persist_private_ip":    true,
+			} {
+				if got := readData.Get(field); got != want {
+					t.Errorf("%s: got %v, want %v", field, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestElastigroupStatefulPersistence_NotConfigured(t *testing.T) {
+	api := newFakeAPI(t)
+	c, err := gcpGroupClient(api.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupGCP().Schema, map[string]interface{}{})
+	resourceData.SetId("sig-12345678")
+
+	if err := gcpGroupStatefulEndpoint.onCreate(context.Background(), resourceData, c); err != nil {
+		t.Fatal(err)
+	}
+	if requests := api.Requests(); len(requests) != 0 {
+		t.Errorf("requests: got %+v, want none", requests)
+	}
+}
+
+func TestElastigroupStatefulInstanceActions(t *testing.T) {
+	api := newFakeAPI(t)
+	c, err := azureV3GroupClient(api.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupAzureV3().Schema, map[string]interface{}{
+		"stateful_instance_action": []interface{}{
+			map[string]interface{}{"stateful_instance_id": "ssi-11111111", "type": "pause"},
+			map[string]interface{}{"stateful_instance_id": "ssi-22222222", "type": "Recycle"},
+		},
+	})
+	resourceData.SetId("sig-12345678")
+
+	if err := azureV3GroupStatefulEndpoint.onUpdate(context.Background(), resourceData, c); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, req := range api.Requests() {
+		got = append(got, req.Method+" "+req.Path)
+	}
+	want := []string{
+		"PUT /azure/compute/group/sig-12345678/statefulInstance/ssi-11111111/pause",
+		"PUT /azure/compute/group/sig-12345678/statefulInstance/ssi-22222222/recycle",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("requests: got %v, want %v", got, want)
+	}
+}
+
+func TestElastigroupStatefulInstanceActions_Duplicate(t *testing.T) {
+	api := newFakeAPI(t)
+	c, err := gcpGroupClient(api.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupGCP().Schema, map[string]interface{}{
+		"stateful_instance_action": []interface{}{
+			map[string]interface{}{"stateful_instance_id": "ssi-11111111", "type": "pause"},
+			map[string]interface{}{"stateful_instance_id": "ssi-11111111", "type": "resume"},
+		},
+	})
+	resourceData.SetId("sig-12345678")
+
+	err = gcpGroupStatefulEndpoint.onUpdate(context.Background(), resourceData, c)
+	if err == nil || !strings.Contains(err.Error(), "multiple actions are not allowed") {
+		t.Errorf("got %v, want an error about multiple actions", err)
+	}
+	if requests := api.Requests(); len(requests) != 0 {
+		t.Errorf("requests: got %+v, want none", requests)
+	}
+}
+
+func TestElastigroupStatefulDeallocation(t *testing.T) {
+	cases := map[string]struct {
+		config map[string]interface{}
+		want   interface{}
+	}{
+		"deallocation": {
+			config: map[string]interface{}{
+				"stateful_deallocation": []interface{}{
+					map[string]interface{}{"should_delete_volumes": true, "should_delete_snapshots": true},
+				},
+			},
+			want: map[string]interface{}{"shouldDeleteVolumes": true, "shouldDeleteSnapshots": true},
+		},
+		"no deallocation": {
+			config: map[string]interface{}{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeAPI(t).Put("/gcp/gce/group/sig-12345678", map[string]interface{}{"id": "sig-12345678"})
+
+			resourceData := schema.TestResourceDataRaw(t, resourceSpotinstElastigroupGCP().Schema, tc.config)
+			resourceData.SetId("sig-12345678")
+
+			if err := deleteGCPGroup(context.Background(), resourceData, api.Client()); err != nil {
+				t.Fatal(err)
+			}
+
+			requests := api.Requests()
+			if len(requests) != 1 || requests[0].Method != http.MethodDelete {
+				t.Fatalf("requests: got %+v, want a single DELETE", requests)
+			}
+			if got := requests[0].Body["statefulDeallocation"]; !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("statefulDeallocation: got %v, want %v", got, tc.want)
+			}
+			if _, ok := api.Object("/gcp/gce/group/sig-12345678"); ok {
+				t.Errorf("expected the group to be deleted")
+			}
+		})
+	}
+}
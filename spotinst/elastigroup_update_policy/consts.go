@@ -0,0 +1,15 @@
+package elastigroup_update_policy
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	UpdatePolicy commons.FieldName = "update_policy"
+	ShouldRoll   commons.FieldName = "should_roll"
+
+	RollConfig          commons.FieldName = "roll_config"
+	BatchSizePercentage commons.FieldName = "batch_size_percentage"
+	GracePeriod         commons.FieldName = "grace_period"
+	HealthCheckType     commons.FieldName = "health_check_type"
+	WaitForRollPct      commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout  commons.FieldName = "wait_for_roll_timeout"
+)
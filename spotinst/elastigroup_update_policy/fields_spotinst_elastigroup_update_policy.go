@@ -0,0 +1,84 @@
+package elastigroup_update_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[UpdatePolicy] = commons.NewGenericField(
+		commons.ElastigroupUpdatePolicy,
+		UpdatePolicy,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldRoll): {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+
+					string(RollConfig): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(BatchSizePercentage): {
+									Type:     schema.TypeInt,
+									Required: true,
+								},
+
+								string(GracePeriod): {
+									Type:     schema.TypeInt,
+									Optional: true,
+									Default:  -1,
+								},
+
+								string(HealthCheckType): {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[UpdatePolicy].AddDiffValidator(validateRollConfig)
+}
+
+func validateRollConfig(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	rollConfig := fmt.Sprintf("%s.0.%s.0", UpdatePolicy, RollConfig)
+
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, BatchSizePercentage)); err != nil {
+		return err
+	}
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffRequiredTogether(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct),
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollTimeout))
+}
@@ -0,0 +1,345 @@
+package spotinst
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeAPI is an in-memory stand-in for the Spotinst REST API. Objects created
+// with POST are stored under the collection path and the ID assigned to them,
+// and can then be read, updated (merged) and deleted using that path. Any other
+// request (e.g. actions like rolls or detaches) succeeds with an empty result.
+type fakeAPI struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu        sync.Mutex
+	nextID    int
+	objects   map[string]map[string]interface{}
+	requests  []fakeAPIRequest
+	keyFields map[string]string
+	wrapKeys  map[string]string
+	aliases   map[string]string
+	responses map[string][]interface{}
+	mappings  map[string]bool
+}
+
+// fakeAPIRequest records a request served by the fake API.
+type fakeAPIRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   map[string]interface{}
+}
+
+// fakeAPIIDFields are the attributes an object's ID may be returned in.
+var fakeAPIIDFields = []string{"id", "userId", "policyId", "groupId", "accountId"}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	api := &fakeAPI{
+		t:         t,
+		objects:   make(map[string]map[string]interface{}),
+		keyFields: make(map[string]string),
+		wrapKeys:  make(map[string]string),
+		aliases:   make(map[string]string),
+		responses: make(map[string][]interface{}),
+		mappings:  make(map[string]bool),
+	}
+	api.server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(api.server.Close)
+	return api
+}
+
+// Client returns a Spotinst client configured to talk to the fake API.
+func (api *fakeAPI) Client() *Client {
+	config := Config{
+		Token:   "fake-token",
+		Account: "act-12345678",
+		BaseURL: api.server.URL,
+	}
+
+	client, diags := config.Client()
+	if diags.HasError() {
+		api.t.Fatalf("failed to configure client: %v", diags)
+	}
+	return client
+}
+
+// KeyBy addresses the objects created in a collection by the given attribute,
+// rather than by their ID. Objects created without the attribute are assigned
+// a generated ID in it.
+func (api *fakeAPI) KeyBy(collection, field string) *fakeAPI {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.keyFields[collection] = field
+	return api
+}
+
+// WrapItems wraps the items returned from a collection, and the objects in
+// it, under the given key, e.g. `{"serviceAccount": {...}}`.
+func (api *fakeAPI) WrapItems(collection, key string) *fakeAPI {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.wrapKeys[collection] = key
+	return api
+}
+
+// Alias serves the requests to a collection, and the objects in it, from
+// another collection, e.g. when objects are listed from a different path than
+// the one they are created in.
+func (api *fakeAPI) Alias(collection, target string) *fakeAPI {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.aliases[collection] = target
+	return api
+}
+
+// Respond serves the given items for any request with the given method and
+// path, instead of the default behavior, e.g. for imports that return an
+// object built by the API.
+func (api *fakeAPI) Respond(method, path string, items ...interface{}) *fakeAPI {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.responses[method+" "+path] = items
+	return api
+}
+
+// Put stores an object at the given path, e.g. an object the resource under
+// test refers to but does not create.
+func (api *fakeAPI) Put(path string, obj map[string]interface{}) *fakeAPI {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.objects[strings.TrimSuffix(path, "/")] = obj
+	return api
+}
+
+// Mapping serves the PUT requests to `<object>/<suffix>` by merging their body
+// into the object, the way the mapping endpoints of the organization replace
+// an attribute of the object, e.g. the users of a user group.
+func (api *fakeAPI) Mapping(suffix string) *fakeAPI {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.mappings[suffix] = true
+	return api
+}
+
+// Requests returns the requests served so far.
+func (api *fakeAPI) Requests() []fakeAPIRequest {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]fakeAPIRequest(nil), api.requests...)
+}
+
+// Object returns the object stored at the given path.
+func (api *fakeAPI) Object(path string) (map[string]interface{}, bool) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	obj, ok := api.objects[strings.TrimSuffix(path, "/")]
+	return obj, ok
+}
+
+func (api *fakeAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	for collection, target := range api.aliases {
+		if path == collection || strings.HasPrefix(path, collection+"/") {
+			path = target + path[len(collection):]
+			break
+		}
+	}
+
+	var body map[string]interface{}
+	if raw, err := ioutil.ReadAll(r.Body); err == nil && len(raw) > 0 {
+		if err := json.Unmarshal(raw, &body); err != nil {
+			api.writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+			return
+		}
+	}
+	api.requests = append(api.requests, fakeAPIRequest{Method: r.Method, Path: path, Query: r.URL.Query(), Body: body})
+
+	if items, ok := api.responses[r.Method+" "+path]; ok {
+		api.writeItems(w, path, append([]interface{}(nil), items...)...)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		obj := unwrapFakeAPIObject(body)
+		keyField := api.keyFields[path]
+		id := fakeAPIObjectID(obj, keyField)
+		if id == "" {
+			if keyField == "" {
+				keyField = "id"
+			}
+			api.nextID++
+			id = fmt.Sprintf("fake-%08d", api.nextID)
+			obj[keyField] = id
+		}
+		api.objects[path+"/"+id] = obj
+		api.writeItems(w, path, obj)
+
+	case http.MethodPut:
+		obj, ok := api.objects[path]
+		if i := strings.LastIndex(path, "/"); !ok && api.mappings[path[i+1:]] {
+			if owner, found := api.objects[path[:i]]; found {
+				mergeFakeAPIObject(owner, body)
+				api.writeItems(w, path)
+				return
+			}
+		}
+		if !ok {
+			// Actions and upserts of objects not created by POST.
+			api.writeItems(w, path)
+			return
+		}
+		mergeFakeAPIObject(obj, unwrapFakeAPIObject(body))
+		api.writeItems(w, path, obj)
+
+	case http.MethodGet:
+		if obj, ok := api.objects[path]; ok {
+			api.writeItems(w, path, obj)
+			return
+		}
+
+		var keys []string
+		for key := range api.objects {
+			if strings.HasPrefix(key, path+"/") && !strings.Contains(key[len(path)+1:], "/") {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 && api.isObjectPath(path) {
+			api.writeError(w, http.StatusBadRequest, "RESOURCE_DOES_NOT_EXIST",
+				fmt.Sprintf("object %s does not exist", path))
+			return
+		}
+
+		sort.Strings(keys)
+		items := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			items = append(items, api.objects[key])
+		}
+		api.writeItems(w, path, items...)
+
+	case http.MethodDelete:
+		delete(api.objects, path)
+		api.writeItems(w, path)
+
+	default:
+		api.writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", r.Method)
+	}
+}
+
+// isObjectPath reports whether the path addresses an object that was created
+// in a collection that is known to the fake API.
+func (api *fakeAPI) isObjectPath(path string) bool {
+	i := strings.LastIndex(path, "/")
+	if i <= 0 {
+		return false
+	}
+
+	collection := path[:i+1]
+	for key := range api.objects {
+		if strings.HasPrefix(key, collection) {
+			return true
+		}
+	}
+	return false
+}
+
+func (api *fakeAPI) writeItems(w http.ResponseWriter, path string, items ...interface{}) {
+	if items == nil {
+		items = []interface{}{}
+	}
+
+	key, ok := api.wrapKeys[path]
+	if !ok {
+		key, ok = api.wrapKeys[path[:strings.LastIndex(path, "/")]]
+	}
+	if ok {
+		for i, item := range items {
+			items[i] = map[string]interface{}{key: item}
+		}
+	}
+	api.write(w, http.StatusOK, map[string]interface{}{
+		"status": map[string]interface{}{"code": http.StatusOK, "message": "OK"},
+		"items":  items,
+		"count":  len(items),
+	})
+}
+
+func (api *fakeAPI) writeError(w http.ResponseWriter, status int, code, message string) {
+	api.write(w, status, map[string]interface{}{
+		"status": map[string]interface{}{"code": status, "message": http.StatusText(status)},
+		"errors": []interface{}{
+			map[string]interface{}{"code": code, "message": message},
+		},
+	})
+}
+
+func (api *fakeAPI) write(w http.ResponseWriter, status int, response map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"request":  map[string]interface{}{"id": fmt.Sprintf("req-%d", len(api.requests))},
+		"response": response,
+	}); err != nil {
+		api.t.Errorf("failed to write fake API response: %v", err)
+	}
+}
+
+// unwrapFakeAPIObject returns the object wrapped in a request body, e.g. the
+// group of a `{"group": {...}}` body, or the body itself when it is unwrapped.
+func unwrapFakeAPIObject(body map[string]interface{}) map[string]interface{} {
+	if len(body) == 1 {
+		for _, v := range body {
+			if obj, ok := v.(map[string]interface{}); ok {
+				return obj
+			}
+		}
+	}
+	if body == nil {
+		body = make(map[string]interface{})
+	}
+	return body
+}
+
+func fakeAPIObjectID(obj map[string]interface{}, keyField string) string {
+	if keyField != "" {
+		id, _ := obj[keyField].(string)
+		return id
+	}
+	for _, field := range fakeAPIIDFields {
+		if id, ok := obj[field].(string); ok && id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// mergeFakeAPIObject merges src into dst, the way the API applies a partial
+// update: nested objects are merged and any other value is replaced.
+func mergeFakeAPIObject(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if srcObj, ok := v.(map[string]interface{}); ok {
+			if dstObj, ok := dst[k].(map[string]interface{}); ok {
+				mergeFakeAPIObject(dstObj, srcObj)
+				continue
+			}
+		}
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		dst[k] = v
+	}
+}
@@ -11,11 +11,18 @@ const (
 	AKSRegion                          commons.FieldName = "aks_region"
 	AKSInfrastructureResourceGroupName commons.FieldName = "aks_infrastructure_resource_group_name"
 
-	UpdatePolicy    commons.FieldName = "update_policy"
-	ShouldRoll      commons.FieldName = "should_roll"
-	ConditionedRoll commons.FieldName = "conditioned_roll"
+	WaitForNodes commons.FieldName = "wait_for_nodes"
+	MinCount     commons.FieldName = "min_count"
+	Timeout      commons.FieldName = "timeout"
+
+	UpdatePolicy                 commons.FieldName = "update_policy"
+	ShouldRoll                   commons.FieldName = "should_roll"
+	ConditionedRoll              commons.FieldName = "conditioned_roll"
+	ConditionedRollExcludeParams commons.FieldName = "conditioned_roll_exclude_params"
 
 	RollConfig                commons.FieldName = "roll_config"
+	WaitForRollPct            commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout        commons.FieldName = "wait_for_roll_timeout"
 	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
 	VngIDs                    commons.FieldName = "vng_ids"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
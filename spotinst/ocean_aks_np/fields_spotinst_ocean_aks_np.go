@@ -1,9 +1,11 @@
 package ocean_aks_np
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 )
@@ -272,6 +274,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeBool,
 						Optional: true,
 					},
+					string(ConditionedRollExcludeParams): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
 					string(RollConfig): {
 						Type:     schema.TypeList,
 						Optional: true,
@@ -283,6 +290,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Optional: true,
 									Default:  -1,
 								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
 								string(VngIDs): {
 									Type:     schema.TypeList,
 									Optional: true,
@@ -322,6 +339,37 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[WaitForNodes] = commons.NewGenericField(
+		commons.OceanAKSNP,
+		WaitForNodes,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(MinCount): {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					string(Timeout): {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[AvailabilityZones].MarkRequiresRoll()
+	fieldsMap[UpdatePolicy].AddDiffValidator(validateRollConfig)
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollExcludeParams)))
 }
 
 func expandZones(data interface{}) ([]string, error) {
@@ -335,3 +383,15 @@ func expandZones(data interface{}) ([]string, error) {
 	}
 	return result, nil
 }
+
+func validateRollConfig(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	rollConfig := fmt.Sprintf("%s.0.%s.0", UpdatePolicy, RollConfig)
+
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffRequiredTogether(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct),
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollTimeout))
+}
@@ -266,6 +266,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[Label].MarkRequiresRoll()
+	fieldsMap[Taint].MarkRequiresRoll()
+	fieldsMap[Tags].MarkRequiresRoll()
 }
 
 func expandTags(data interface{}) (*map[string]string, error) {
@@ -453,6 +453,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[MaxPodsPerNode].MarkRequiresRoll()
+	fieldsMap[EnableNodePublicIP].MarkRequiresRoll()
+	fieldsMap[OsDiskSizeGB].MarkRequiresRoll()
+	fieldsMap[OsDiskType].MarkRequiresRoll()
+	fieldsMap[OsSKU].MarkRequiresRoll()
+	fieldsMap[KubernetesVersion].MarkRequiresRoll()
+	fieldsMap[VnetSubnetIDs].MarkRequiresRoll()
+	fieldsMap[PodSubnetIDs].MarkRequiresRoll()
 }
 
 func flattenLinuxOSConfig(linuxConfig *azure_np.LinuxOSConfig) []interface{} {
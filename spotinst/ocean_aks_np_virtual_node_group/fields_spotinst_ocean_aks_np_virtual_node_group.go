@@ -320,6 +320,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeBool,
 						Optional: true,
 					},
+					string(ConditionedRollParams): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
+					string(ConditionedRollExcludeParams): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
 					string(RollConfig): {
 						Type:     schema.TypeList,
 						Optional: true,
@@ -370,6 +380,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollParams)))
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollExcludeParams)))
+	fieldsMap[AvailabilityZones].MarkRequiresRoll()
+	fieldsMap[Labels].MarkRequiresRoll()
+	fieldsMap[Taints].MarkRequiresRoll()
+	fieldsMap[Tags].MarkRequiresRoll()
 }
 
 func expandAvailaiblityZones(data interface{}) ([]string, error) {
@@ -1,10 +1,12 @@
 package ocean_aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -383,6 +385,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeInt,
 									Required: true,
 								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
 								string(LaunchSpecIDs): {
 									Type:     schema.TypeList,
 									Optional: true,
@@ -474,6 +486,38 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[WaitForNodes] = commons.NewGenericField(
+		commons.OceanAWS,
+		WaitForNodes,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(MinCount): {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					string(Timeout): {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[DesiredCapacity].AddDiffValidator(validateCapacity)
+	fieldsMap[UpdatePolicy].AddDiffValidator(validateRollConfig)
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollParams)))
+	fieldsMap[SubnetIDs].MarkRequiresRoll()
 }
 
 func expandSubnetIDs(data interface{}) ([]string, error) {
@@ -523,3 +567,21 @@ func expandTags(data interface{}) ([]*aws.Tag, error) {
 	}
 	return tags, nil
 }
+
+func validateCapacity(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	return commons.ValidateDiffCapacity(resourceDiff, MinSize, DesiredCapacity, MaxSize)
+}
+
+func validateRollConfig(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	rollConfig := fmt.Sprintf("%s.0.%s.0", UpdatePolicy, RollConfig)
+
+	for _, field := range []commons.FieldName{BatchSizePercentage, BatchMinHealthyPercentage, WaitForRollPct} {
+		if err := commons.ValidateDiffPercentage(resourceDiff,
+			fmt.Sprintf("%s.%s", rollConfig, field)); err != nil {
+			return err
+		}
+	}
+	return commons.ValidateDiffRequiredTogether(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct),
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollTimeout))
+}
@@ -291,6 +291,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[Whitelist].MarkRequiresRoll()
+	fieldsMap[Blacklist].MarkRequiresRoll()
 }
 
 func expandFilters(data interface{}, nullify bool) (*aws.Filters, error) {
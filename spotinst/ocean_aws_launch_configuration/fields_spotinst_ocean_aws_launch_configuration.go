@@ -981,6 +981,24 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[UserData].MarkRequiresRoll()
+	fieldsMap[ImageID].MarkRequiresRoll()
+	fieldsMap[SecurityGroups].MarkRequiresRoll()
+	fieldsMap[KeyName].MarkRequiresRoll()
+	fieldsMap[IAMInstanceProfile].MarkRequiresRoll()
+	fieldsMap[AssociatePublicIpAddress].MarkRequiresRoll()
+	fieldsMap[LoadBalancers].MarkRequiresRoll()
+	fieldsMap[InstanceMetadataOptions].MarkRequiresRoll()
+	fieldsMap[EBSOptimized].MarkRequiresRoll()
+	fieldsMap[RootVolumeSize].MarkRequiresRoll()
+
+	// Schema version 0 kept the empty SHA1 sum returned by the EC2 API for the
+	// user data of clusters without any.
+	fieldsMap[UserData].AddStateUpgrader(&commons.FieldStateUpgrader{
+		Version: 0,
+		Upgrade: commons.NormalizeFieldState(UserData, normalizeEmptySHA1),
+	})
 }
 
 func flattenResourceTagSpecification(resourceTagSpecification *aws.ResourceTagSpecification) []interface{} {
@@ -1014,6 +1032,16 @@ func expandResourceTagSpecification(data interface{}) (*aws.ResourceTagSpecifica
 
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
 
+// emptySHA1 is the SHA1 sum of empty user data.
+const emptySHA1 = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+
+func normalizeEmptySHA1(v interface{}) interface{} {
+	if s, ok := v.(string); ok && s == emptySHA1 {
+		return ""
+	}
+	return v
+}
+
 func Base64StateFunc(v interface{}) string {
 	if isBase64Encoded(v.(string)) {
 		return v.(string)
@@ -102,10 +102,15 @@ const (
 )
 
 const (
-	UpdatePolicy commons.FieldName = "update_policy"
-	ShouldRoll   commons.FieldName = "should_roll"
+	UpdatePolicy                 commons.FieldName = "update_policy"
+	ShouldRoll                   commons.FieldName = "should_roll"
+	ConditionedRoll              commons.FieldName = "conditioned_roll"
+	ConditionedRollParams        commons.FieldName = "conditioned_roll_params"
+	ConditionedRollExcludeParams commons.FieldName = "conditioned_roll_exclude_params"
 
 	RollConfig          commons.FieldName = "roll_config"
+	WaitForRollPct      commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout  commons.FieldName = "wait_for_roll_timeout"
 	BatchSizePercentage commons.FieldName = "batch_size_percentage"
 	RespectPDB          commons.FieldName = "respect_pdb"
 )
@@ -1,6 +1,7 @@
 package ocean_aws_launch_spec
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -1368,6 +1369,22 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 
+					string(ConditionedRoll): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
+					string(ConditionedRollParams): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
+					string(ConditionedRollExcludeParams): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
+
 					string(RollConfig): {
 						Type:     schema.TypeList,
 						Optional: true,
@@ -1378,6 +1395,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeInt,
 									Required: true,
 								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
 								string(RespectPDB): {
 									Type:     schema.TypeBool,
 									Optional: true,
@@ -2025,6 +2052,25 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[UpdatePolicy].AddDiffValidator(validateRollConfig)
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollParams)))
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollExcludeParams)))
+	fieldsMap[SubnetIDs].MarkRequiresRoll()
+	fieldsMap[InstanceTypes].MarkRequiresRoll()
+	fieldsMap[InstanceTypesFilters].MarkRequiresRoll()
+	fieldsMap[UserData].MarkRequiresRoll()
+	fieldsMap[ImageID].MarkRequiresRoll()
+	fieldsMap[Images].MarkRequiresRoll()
+	fieldsMap[SecurityGroups].MarkRequiresRoll()
+	fieldsMap[IamInstanceProfile].MarkRequiresRoll()
+	fieldsMap[AssociatePublicIPAddress].MarkRequiresRoll()
+	fieldsMap[InstanceMetadataOptions].MarkRequiresRoll()
+	fieldsMap[BlockDeviceMappings].MarkRequiresRoll()
+	fieldsMap[RootVolumeSize].MarkRequiresRoll()
+	fieldsMap[EphemeralStorage].MarkRequiresRoll()
 }
 
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
@@ -3190,3 +3236,15 @@ func expandEphemeralStorage(data interface{}) (*aws.EphemeralStorage, error) {
 
 	return nil, nil
 }
+
+func validateRollConfig(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	rollConfig := fmt.Sprintf("%s.0.%s.0", UpdatePolicy, RollConfig)
+
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffRequiredTogether(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct),
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollTimeout))
+}
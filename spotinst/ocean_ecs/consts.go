@@ -17,6 +17,8 @@ const (
 	ConditionedRoll           commons.FieldName = "conditioned_roll"
 	AutoApplyTags             commons.FieldName = "auto_apply_tags"
 	RollConfig                commons.FieldName = "roll_config"
+	WaitForRollPct            commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout        commons.FieldName = "wait_for_roll_timeout"
 	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
 	Tags                      commons.FieldName = "tags"
@@ -1,6 +1,7 @@
 package ocean_ecs
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -310,6 +311,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeInt,
 									Required: true,
 								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
 								string(BatchMinHealthyPercentage): {
 									Type:     schema.TypeInt,
 									Optional: true,
@@ -387,6 +398,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[SubnetIDs].MarkRequiresRoll()
+	fieldsMap[UpdatePolicy].AddDiffValidator(validateRollConfig)
 }
 
 func expandSubnetIDs(data interface{}) ([]string, error) {
@@ -436,3 +450,15 @@ func expandTags(data interface{}) ([]*aws.Tag, error) {
 	}
 	return tags, nil
 }
+
+func validateRollConfig(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	rollConfig := fmt.Sprintf("%s.0.%s.0", UpdatePolicy, RollConfig)
+
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffRequiredTogether(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct),
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollTimeout))
+}
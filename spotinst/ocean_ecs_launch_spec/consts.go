@@ -83,3 +83,14 @@ const (
 const (
 	ImageId commons.FieldName = "image_id"
 )
+
+const (
+	UpdatePolicy commons.FieldName = "update_policy"
+	ShouldRoll   commons.FieldName = "should_roll"
+
+	RollConfig                commons.FieldName = "roll_config"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+	WaitForRollPct            commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout        commons.FieldName = "wait_for_roll_timeout"
+)
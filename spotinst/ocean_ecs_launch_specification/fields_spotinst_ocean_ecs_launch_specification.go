@@ -661,6 +661,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[UserData].MarkRequiresRoll()
+	fieldsMap[ImageID].MarkRequiresRoll()
+	fieldsMap[SecurityGroupIds].MarkRequiresRoll()
+	fieldsMap[KeyPair].MarkRequiresRoll()
+	fieldsMap[IamInstanceProfile].MarkRequiresRoll()
+	fieldsMap[AssociatePublicIpAddress].MarkRequiresRoll()
+	fieldsMap[BlockDeviceMappings].MarkRequiresRoll()
+	fieldsMap[InstanceMetadataOptions].MarkRequiresRoll()
 }
 
 var InstanceProfileArnRegex = regexp.MustCompile(`arn:aws:iam::\d{12}:instance-profile/?[a-zA-Z_0-9+=,.@\-_/]+`)
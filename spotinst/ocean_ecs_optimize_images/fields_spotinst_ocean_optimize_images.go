@@ -83,6 +83,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[OptimizeImages].MarkRequiresRoll()
 }
 
 func flattenOptimizeImages(oi *aws.ECSOptimizeImages) []interface{} {
@@ -19,6 +19,18 @@ const (
 	NamedPorts      commons.FieldName = "named_ports"
 	Ports           commons.FieldName = "ports"
 	ServiceName     commons.FieldName = "service_name"
+
+	UpdatePolicy    commons.FieldName = "update_policy"
+	ShouldRoll      commons.FieldName = "should_roll"
+	ConditionedRoll commons.FieldName = "conditioned_roll"
+
+	RollConfig                commons.FieldName = "roll_config"
+	WaitForRollPct            commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout        commons.FieldName = "wait_for_roll_timeout"
+	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
+	LaunchSpecIDs             commons.FieldName = "launch_spec_ids"
+	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
+	RespectPdb                commons.FieldName = "respect_pdb"
 )
 
 type LabelField string
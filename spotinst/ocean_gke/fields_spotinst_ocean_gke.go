@@ -1,6 +1,7 @@
 package ocean_gke
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -600,6 +601,73 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[UpdatePolicy] = commons.NewGenericField(
+		commons.OceanGKE,
+		UpdatePolicy,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ShouldRoll): {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					string(ConditionedRoll): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
+					string(RollConfig): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(BatchSizePercentage): {
+									Type:     schema.TypeInt,
+									Required: true,
+								},
+
+								string(WaitForRollPct): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
+
+								string(WaitForRollTimeout): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+								string(LaunchSpecIDs): {
+									Type:     schema.TypeList,
+									Optional: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+								string(BatchMinHealthyPercentage): {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+								string(RespectPdb): {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[BackendServices].MarkRequiresRoll()
+	fieldsMap[SourceImage].MarkRequiresRoll()
+	fieldsMap[Metadata].MarkRequiresRoll()
+	fieldsMap[Labels].MarkRequiresRoll()
+	fieldsMap[SubnetName].MarkRequiresRoll()
+	fieldsMap[UpdatePolicy].AddDiffValidator(validateRollConfig)
 }
 
 func expandServices(data interface{}) ([]*gcp.BackendService, error) {
@@ -738,3 +806,15 @@ func flattenMetadata(metadata []*gcp.Metadata) []interface{} {
 	}
 	return result
 }
+
+func validateRollConfig(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	rollConfig := fmt.Sprintf("%s.0.%s.0", UpdatePolicy, RollConfig)
+
+	if err := commons.ValidateDiffPercentage(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct)); err != nil {
+		return err
+	}
+	return commons.ValidateDiffRequiredTogether(resourceDiff,
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollPct),
+		fmt.Sprintf("%s.%s", rollConfig, WaitForRollTimeout))
+}
@@ -18,4 +18,8 @@ const (
 	NumOfUnits                   commons.FieldName = "num_of_units"
 	ResourceLimits               commons.FieldName = "resource_limits"
 	IsAggressiveScaleDownEnabled commons.FieldName = "is_aggressive_scale_down_enabled"
+
+	MaxScaleDownPercentage           commons.FieldName = "max_scale_down_percentage"
+	AutoHeadroomPercentage           commons.FieldName = "auto_headroom_percentage"
+	EnableAutomaticAndManualHeadroom commons.FieldName = "enable_automatic_and_manual_headroom"
 )
@@ -1,6 +1,8 @@
 package ocean_gke_auto_scaling
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -14,6 +16,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			MaxItems: 1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -33,6 +36,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeInt,
 									Optional: true,
 								},
+								string(MaxScaleDownPercentage): {
+									Type:     schema.TypeFloat,
+									Optional: true,
+								},
 								string(IsAggressiveScaleDownEnabled): {
 									Type:     schema.TypeBool,
 									Optional: true,
@@ -79,6 +86,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Optional: true,
 					},
 
+					string(AutoHeadroomPercentage): {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+
+					string(EnableAutomaticAndManualHeadroom): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
 					string(ResourceLimits): {
 						Type:     schema.TypeList,
 						Optional: true,
@@ -102,6 +119,19 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var result []interface{} = nil
+
+			if cluster != nil && cluster.AutoScaler != nil {
+				result = flattenAutoscaler(cluster.AutoScaler)
+			}
+
+			if len(result) > 0 {
+				if err := resourceData.Set(string(Autoscaler), result); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Autoscaler), err)
+				}
+			}
 			return nil
 		},
 
@@ -180,6 +210,16 @@ func expandAutoscaler(data interface{}, nullify bool) (*gcp.AutoScaler, error) {
 		autoscaler.SetIsEnabled(spotinst.Bool(v))
 	}
 
+	if v, ok := m[string(AutoHeadroomPercentage)].(int); ok && v > 0 {
+		autoscaler.SetAutoHeadroomPercentage(spotinst.Int(v))
+	} else if nullify {
+		autoscaler.SetAutoHeadroomPercentage(nil)
+	}
+
+	if v, ok := m[string(EnableAutomaticAndManualHeadroom)].(bool); ok {
+		autoscaler.SetEnableAutomaticAndManualHeadroom(spotinst.Bool(v))
+	}
+
 	if v, ok := m[string(ResourceLimits)]; ok {
 		resLimits, err := expandOceanGKEAutoScalerResourceLimits(v)
 		if err != nil {
@@ -214,7 +254,7 @@ func expandOceanGKEAutoScalerHeadroom(data interface{}) (*gcp.AutoScalerHeadroom
 			}
 
 			if v, ok := m[string(GPUPerUnit)].(int); ok && v >= 0 {
-				headroom.SetNumOfUnits(spotinst.Int(v))
+				headroom.SetGPUPerUnit(spotinst.Int(v))
 			}
 		}
 		return headroom, nil
@@ -254,6 +294,12 @@ func expandOceanGKEAutoScalerDown(data interface{}) (*gcp.AutoScalerDown, error)
 				autoScaleDown.SetEvaluationPeriods(spotinst.Int(v))
 			}
 
+			if v, ok := m[string(MaxScaleDownPercentage)].(float64); ok && v > 0 {
+				autoScaleDown.SetMaxScaleDownPercentage(spotinst.Float64(v))
+			} else {
+				autoScaleDown.SetMaxScaleDownPercentage(nil)
+			}
+
 			if v, ok := m[string(IsAggressiveScaleDownEnabled)].(bool); ok {
 				aggressiveScaleDown := &gcp.AggressiveScaleDown{}
 				autoScaleDown.SetAggressiveScaleDown(aggressiveScaleDown)
@@ -265,3 +311,63 @@ func expandOceanGKEAutoScalerDown(data interface{}) (*gcp.AutoScalerDown, error)
 
 	return nil, nil
 }
+
+func flattenAutoscaler(autoScaler *gcp.AutoScaler) []interface{} {
+	var out []interface{}
+
+	if autoScaler != nil {
+		result := make(map[string]interface{})
+
+		result[string(AutoscaleIsEnabled)] = spotinst.BoolValue(autoScaler.IsEnabled)
+		result[string(AutoscaleCooldown)] = spotinst.IntValue(autoScaler.Cooldown)
+		result[string(AutoscaleIsAutoConfig)] = spotinst.BoolValue(autoScaler.IsAutoConfig)
+		result[string(AutoHeadroomPercentage)] = spotinst.IntValue(autoScaler.AutoHeadroomPercentage)
+		result[string(EnableAutomaticAndManualHeadroom)] = spotinst.BoolValue(autoScaler.EnableAutomaticAndManualHeadroom)
+
+		if autoScaler.Headroom != nil {
+			result[string(AutoscaleHeadroom)] = flattenAutoScaleHeadroom(autoScaler.Headroom)
+		}
+
+		if autoScaler.Down != nil {
+			result[string(AutoscaleDown)] = flattenAutoScaleDown(autoScaler.Down)
+		}
+
+		if autoScaler.ResourceLimits != nil {
+			result[string(ResourceLimits)] = flattenAutoScaleResourceLimits(autoScaler.ResourceLimits)
+		}
+
+		if len(result) > 0 {
+			out = append(out, result)
+		}
+	}
+
+	return out
+}
+
+func flattenAutoScaleHeadroom(autoScaleHeadroom *gcp.AutoScalerHeadroom) []interface{} {
+	headroom := make(map[string]interface{})
+	headroom[string(CPUPerUnit)] = spotinst.IntValue(autoScaleHeadroom.CPUPerUnit)
+	headroom[string(GPUPerUnit)] = spotinst.IntValue(autoScaleHeadroom.GPUPerUnit)
+	headroom[string(MemoryPerUnit)] = spotinst.IntValue(autoScaleHeadroom.MemoryPerUnit)
+	headroom[string(NumOfUnits)] = spotinst.IntValue(autoScaleHeadroom.NumOfUnits)
+
+	return []interface{}{headroom}
+}
+
+func flattenAutoScaleDown(autoScaleDown *gcp.AutoScalerDown) []interface{} {
+	down := make(map[string]interface{})
+	down[string(EvaluationPeriods)] = spotinst.IntValue(autoScaleDown.EvaluationPeriods)
+	down[string(MaxScaleDownPercentage)] = spotinst.Float64Value(autoScaleDown.MaxScaleDownPercentage)
+	if autoScaleDown.AggressiveScaleDown != nil {
+		down[string(IsAggressiveScaleDownEnabled)] = spotinst.BoolValue(autoScaleDown.AggressiveScaleDown.IsEnabled)
+	}
+
+	return []interface{}{down}
+}
+
+func flattenAutoScaleResourceLimits(autoScalerResourceLimits *gcp.AutoScalerResourceLimits) []interface{} {
+	limits := make(map[string]interface{})
+	limits[string(MaxVCPU)] = spotinst.IntValue(autoScalerResourceLimits.MaxVCPU)
+	limits[string(MaxMemoryGIB)] = spotinst.IntValue(autoScalerResourceLimits.MaxMemoryGiB)
+	return []interface{}{limits}
+}
@@ -22,11 +22,17 @@ const (
 	// Deprecated: Please use ControllerClusterID instead.
 	ClusterControllerID commons.FieldName = "cluster_controller_id"
 
+	WaitForNodes commons.FieldName = "wait_for_nodes"
+	MinCount     commons.FieldName = "min_count"
+	Timeout      commons.FieldName = "timeout"
+
 	UpdatePolicy    commons.FieldName = "update_policy"
 	ShouldRoll      commons.FieldName = "should_roll"
 	ConditionedRoll commons.FieldName = "conditioned_roll"
 
 	RollConfig                commons.FieldName = "roll_config"
+	WaitForRollPct            commons.FieldName = "wait_for_roll_percentage"
+	WaitForRollTimeout        commons.FieldName = "wait_for_roll_timeout"
 	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
 	LaunchSpecIDs             commons.FieldName = "launch_spec_ids"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
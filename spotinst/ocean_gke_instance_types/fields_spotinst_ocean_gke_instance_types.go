@@ -59,4 +59,6 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[Whitelist].MarkRequiresRoll()
 }
@@ -0,0 +1,14 @@
+package ocean_gke_launch_configuration
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	RootVolumeType    commons.FieldName = "root_volume_type"
+	UseAsTemplateOnly commons.FieldName = "use_as_template_only"
+)
+
+const (
+	ShieldedInstanceConfig    commons.FieldName = "shielded_instance_config"
+	EnableSecureBoot          commons.FieldName = "enable_secure_boot"
+	EnableIntegrityMonitoring commons.FieldName = "enable_integrity_monitoring"
+)
@@ -0,0 +1,232 @@
+package ocean_gke_launch_configuration
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[RootVolumeType] = commons.NewGenericField(
+		commons.OceanGKELaunchConfiguration,
+		RootVolumeType,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var result *string = nil
+			if cluster != nil && cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil && cluster.Compute.LaunchSpecification.RootVolumeType != nil {
+				result = cluster.Compute.LaunchSpecification.RootVolumeType
+			}
+			if result != nil {
+				if err := resourceData.Set(string(RootVolumeType), result); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(RootVolumeType), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var rootVolumeType *string = nil
+
+			if cluster != nil && cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil {
+				rootVolumeType = cluster.Compute.LaunchSpecification.RootVolumeType
+
+				// get rootVolumeType from user configuration.
+				if v, ok := resourceData.GetOk(string(RootVolumeType)); ok {
+					rootVolumeType = spotinst.String(v.(string))
+
+					if rootVolumeType != nil {
+						cluster.Compute.LaunchSpecification.SetRootVolumeType(rootVolumeType)
+					}
+				}
+
+			}
+
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var rootVolumeType *string = nil
+			if v, ok := resourceData.GetOk(string(RootVolumeType)); ok && v != "" {
+				rootVolumeType = spotinst.String(v.(string))
+			}
+			cluster.Compute.LaunchSpecification.SetRootVolumeType(rootVolumeType)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ShieldedInstanceConfig] = commons.NewGenericField(
+		commons.OceanGKELaunchConfiguration,
+		ShieldedInstanceConfig,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(EnableSecureBoot): {
+						Type:     schema.TypeBool,
+						Computed: true,
+						Optional: true,
+					},
+					string(EnableIntegrityMonitoring): {
+						Type:     schema.TypeBool,
+						Computed: true,
+						Optional: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var result []interface{} = nil
+			if cluster != nil && cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.ShieldedInstanceConfig != nil {
+				shieldedInstanceConfig := cluster.Compute.LaunchSpecification.ShieldedInstanceConfig
+				result = flattenShieldedInstanceConfig(shieldedInstanceConfig)
+			}
+			if result != nil {
+				if err := resourceData.Set(string(ShieldedInstanceConfig), result); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ShieldedInstanceConfig), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *gcp.LaunchSpecShieldedInstanceConfig = nil
+
+			if v, ok := resourceData.GetOk(string(ShieldedInstanceConfig)); ok {
+
+				if cluster != nil && cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+					cluster.Compute.LaunchSpecification.ShieldedInstanceConfig != nil {
+					value = cluster.Compute.LaunchSpecification.ShieldedInstanceConfig
+				}
+
+				if shieldedInstanceConfig, err := expandShieldedInstanceConfig(v); err != nil {
+					return err
+				} else if shieldedInstanceConfig != nil {
+					value = shieldedInstanceConfig
+				}
+
+				cluster.Compute.LaunchSpecification.SetShieldedInstanceConfig(value)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *gcp.LaunchSpecShieldedInstanceConfig = nil
+
+			if v, ok := resourceData.GetOk(string(ShieldedInstanceConfig)); ok {
+				if shieldedInstanceConfig, err := expandShieldedInstanceConfig(v); err != nil {
+					return err
+				} else {
+					value = shieldedInstanceConfig
+				}
+			}
+			cluster.Compute.LaunchSpecification.SetShieldedInstanceConfig(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[UseAsTemplateOnly] = commons.NewGenericField(
+		commons.OceanGKELaunchConfiguration,
+		UseAsTemplateOnly,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+
+			var value *bool = nil
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil &&
+				cluster.Compute.LaunchSpecification.UseAsTemplateOnly != nil {
+
+				value = cluster.Compute.LaunchSpecification.UseAsTemplateOnly
+			}
+
+			if err := resourceData.Set(string(UseAsTemplateOnly), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UseAsTemplateOnly), err)
+			}
+
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+
+			if v, ok := resourceData.Get(string(UseAsTemplateOnly)).(bool); ok {
+				cluster.Compute.LaunchSpecification.SetUseAsTemplateOnly(spotinst.Bool(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+
+			if v, ok := resourceData.Get(string(UseAsTemplateOnly)).(bool); ok {
+				cluster.Compute.LaunchSpecification.SetUseAsTemplateOnly(spotinst.Bool(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[RootVolumeType].MarkRequiresRoll()
+	fieldsMap[ShieldedInstanceConfig].MarkRequiresRoll()
+}
+
+func flattenShieldedInstanceConfig(shieldedInstanceConfig *gcp.LaunchSpecShieldedInstanceConfig) []interface{} {
+	var out []interface{}
+
+	if shieldedInstanceConfig != nil {
+		result := make(map[string]interface{})
+
+		if shieldedInstanceConfig.EnableSecureBoot != nil {
+			result[string(EnableSecureBoot)] = spotinst.BoolValue(shieldedInstanceConfig.EnableSecureBoot)
+		}
+		if shieldedInstanceConfig.EnableIntegrityMonitoring != nil {
+			result[string(EnableIntegrityMonitoring)] = spotinst.BoolValue(shieldedInstanceConfig.EnableIntegrityMonitoring)
+		}
+		if len(result) > 0 {
+			out = append(out, result)
+		}
+	}
+	return out
+}
+
+func expandShieldedInstanceConfig(data interface{}) (*gcp.LaunchSpecShieldedInstanceConfig, error) {
+	shieldedInstanceConfig := &gcp.LaunchSpecShieldedInstanceConfig{}
+	list := data.([]interface{})
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(EnableSecureBoot)].(bool); ok {
+			shieldedInstanceConfig.SetEnableSecureBoot(spotinst.Bool(v))
+		}
+
+		if v, ok := m[string(EnableIntegrityMonitoring)].(bool); ok {
+			shieldedInstanceConfig.SetEnableIntegrityMonitoring(spotinst.Bool(v))
+		}
+	}
+
+	return shieldedInstanceConfig, nil
+}
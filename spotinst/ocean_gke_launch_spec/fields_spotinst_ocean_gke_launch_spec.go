@@ -1114,6 +1114,22 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 
+					string(ConditionedRoll): {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+
+					string(ConditionedRollParams): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
+					string(ConditionedRollExcludeParams): {
+						Type:     schema.TypeList,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Optional: true,
+					},
+
 					string(RollConfig): {
 						Type:     schema.TypeList,
 						Optional: true,
@@ -1239,6 +1255,22 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollParams)))
+	fieldsMap[UpdatePolicy].AddDiffValidator(commons.ValidateConditionedRollParams(fieldsMap,
+		fmt.Sprintf("%s.0.%s", UpdatePolicy, ConditionedRollExcludeParams)))
+	fieldsMap[SourceImage].MarkRequiresRoll()
+	fieldsMap[Metadata].MarkRequiresRoll()
+	fieldsMap[Labels].MarkRequiresRoll()
+	fieldsMap[Taints].MarkRequiresRoll()
+	fieldsMap[RootVolumeType].MarkRequiresRoll()
+	fieldsMap[RootVolumeSizeInGB].MarkRequiresRoll()
+	fieldsMap[InstanceTypes].MarkRequiresRoll()
+	fieldsMap[ShieldedInstanceConfig].MarkRequiresRoll()
+	fieldsMap[ServiceAccount].MarkRequiresRoll()
+	fieldsMap[Storage].MarkRequiresRoll()
+	fieldsMap[NetworkInterfaces].MarkRequiresRoll()
 }
 
 func expandTaints(data interface{}, taints []*gcp.Taint) ([]*gcp.Taint, error) {
@@ -90,6 +90,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[NetworkInterface].MarkRequiresRoll()
 }
 
 // expandNetworkInterface sets the values from the plan as objects
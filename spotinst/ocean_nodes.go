@@ -0,0 +1,189 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// oceanNodesReader returns the number of running nodes of a cluster, and the
+// total number of its nodes.
+type oceanNodesReader func(ctx context.Context) (running, total int, err error)
+
+// getOceanWaitForNodesConfig returns the `min_count` and `timeout` values
+// configured in a wait_for_nodes block.
+func getOceanWaitForNodesConfig(resourceData *schema.ResourceData, waitField, minCountField, timeoutField commons.FieldName) (int, int) {
+	var minCount, timeout int
+
+	if list, ok := resourceData.Get(string(waitField)).([]interface{}); ok && len(list) > 0 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(minCountField)].(int); ok {
+			minCount = v
+		}
+
+		if v, ok := m[string(timeoutField)].(int); ok {
+			timeout = v
+		}
+	}
+
+	return minCount, timeout
+}
+
+// waitForOceanNodes waits for the number of running nodes set in the
+// wait_for_nodes block of the resource. It does not wait when the block is
+// not set.
+func waitForOceanNodes(ctx context.Context, resourceData *schema.ResourceData, waitField, minCountField, timeoutField commons.FieldName, read oceanNodesReader) error {
+	minCount, timeout := getOceanWaitForNodesConfig(resourceData, waitField, minCountField, timeoutField)
+	return awaitOceanNodes(ctx, resourceData.Id(), minCount, timeout, read)
+}
+
+// awaitOceanNodes polls the nodes of a cluster until at least minCount of
+// them are running, or fails after timeout seconds with the number of nodes
+// that are running so far.
+func awaitOceanNodes(ctx context.Context, clusterID string, minCount, timeout int, read oceanNodesReader) error {
+	if minCount == 0 || timeout == 0 {
+		return nil
+	}
+
+	log.Printf("awaitOceanNodes() Waiting for %d running nodes of cluster: %s", minCount, clusterID)
+
+	var running, total int
+	var readErr error
+	err := resource.RetryContext(ctx, time.Second*time.Duration(timeout), func() *resource.RetryError {
+		running, total, readErr = read(ctx)
+		if readErr != nil {
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitOceanNodes() -> nodes of cluster [%v] API call failed, error: %v", clusterID, readErr))
+		}
+
+		if running < minCount {
+			log.Printf("===> waiting for %d more running nodes <===\n", minCount-running)
+			return resource.RetryableError(fmt.Errorf("===> waiting for %d more running nodes <===", minCount-running))
+		}
+
+		log.Printf("awaitOceanNodes() -> Target number of running nodes reached [%v]", clusterID)
+		return nil
+	})
+	if err != nil && readErr == nil {
+		// RetryContext returns the last retryable error when timing out,
+		// which does not tell how many nodes are running.
+		return fmt.Errorf("[ERROR] Nodes not ready: %d of %d nodes of cluster [%v] are running, "+
+			"waiting for %d running nodes", running, total, clusterID, minCount)
+	}
+	return err
+}
+
+func readOceanAWSNodes(spotinstClient *Client, clusterID string) oceanNodesReader {
+	return func(ctx context.Context) (int, int, error) {
+		out, err := spotinstClient.ocean.CloudProviderAWS().ListClusterInstances(ctx, &aws.ListClusterInstancesInput{
+			ClusterID: spotinst.String(clusterID),
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		running := 0
+		for _, instance := range out.Instances {
+			if strings.EqualFold(spotinst.StringValue(instance.Status), "running") {
+				running++
+			}
+		}
+		return running, len(out.Instances), nil
+	}
+}
+
+// The SDK does not expose the nodes of AKS and GKE clusters, so they are
+// listed directly using the underlying API client.
+
+// oceanNode is the part of an AKS or GKE node listing that tells whether the
+// node is running.
+type oceanNode struct {
+	Status *string `json:"status,omitempty"`
+}
+
+// isRunning reports whether the node is running, or ready to run pods.
+func (n *oceanNode) isRunning() bool {
+	status := spotinst.StringValue(n.Status)
+	return strings.EqualFold(status, "running") || strings.EqualFold(status, "ready")
+}
+
+func readOceanAKSNodes(spotinstClient *Client, clusterID string) oceanNodesReader {
+	return func(ctx context.Context) (int, int, error) {
+		svc, ok := spotinstClient.ocean.CloudProviderAzureNP().(*azure_np.ServiceOp)
+		if !ok {
+			return 0, 0, fmt.Errorf("unsupported ocean/azure/np service implementation")
+		}
+
+		path, err := uritemplates.Expand("/ocean/azure/np/cluster/{clusterId}/nodes", uritemplates.Values{
+			"clusterId": clusterID,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return countOceanRunningNodes(ctx, svc.Client, path)
+	}
+}
+
+func readOceanGKENodes(spotinstClient *Client, clusterID string) oceanNodesReader {
+	return func(ctx context.Context) (int, int, error) {
+		svc, ok := spotinstClient.ocean.CloudProviderGCP().(*gcp.ServiceOp)
+		if !ok {
+			return 0, 0, fmt.Errorf("unsupported ocean/gcp service implementation")
+		}
+
+		path, err := uritemplates.Expand("/ocean/gcp/k8s/cluster/{clusterId}/nodes", uritemplates.Values{
+			"clusterId": clusterID,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return countOceanRunningNodes(ctx, svc.Client, path)
+	}
+}
+
+func countOceanRunningNodes(ctx context.Context, c *client.Client, path string) (int, int, error) {
+	resp, err := client.RequireOK(c.Do(ctx, client.NewRequest(http.MethodGet, path)))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var rw client.Response
+	if err := json.Unmarshal(body, &rw); err != nil {
+		return 0, 0, err
+	}
+
+	running := 0
+	for _, item := range rw.Response.Items {
+		node := new(oceanNode)
+		if err := json.Unmarshal(item, node); err != nil {
+			return 0, 0, err
+		}
+		if node.isRunning() {
+			running++
+		}
+	}
+	return running, len(rw.Response.Items), nil
+}
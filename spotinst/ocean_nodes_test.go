@@ -0,0 +1,68 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWaitForOceanNodes_AWS(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/ocean/aws/k8s/cluster/o-12345678/instances",
+			map[string]interface{}{"instanceId": "i-1", "status": "running"},
+			map[string]interface{}{"instanceId": "i-2", "status": "running"},
+			map[string]interface{}{"instanceId": "i-3", "status": "pending"})
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstOceanAWS().Schema, map[string]interface{}{
+		"wait_for_nodes": []interface{}{
+			map[string]interface{}{
+				"min_count": 2,
+				"timeout":   10,
+			},
+		},
+	})
+	resourceData.SetId("o-12345678")
+
+	if err := waitForOceanNodes(context.Background(), resourceData, "wait_for_nodes", "min_count", "timeout",
+		readOceanAWSNodes(api.Client(), resourceData.Id())); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(api.Requests()); got != 1 {
+		t.Errorf("requests: got %d, want 1", got)
+	}
+}
+
+func TestAwaitOceanNodes_AKSTimeout(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/ocean/azure/np/cluster/o-12345678/nodes",
+			map[string]interface{}{"nodeName": "aks-node-1", "status": "Running"},
+			map[string]interface{}{"nodeName": "aks-node-2", "status": "Pending"})
+
+	err := awaitOceanNodes(context.Background(), "o-12345678", 2, 1, readOceanAKSNodes(api.Client(), "o-12345678"))
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if want := "1 of 2 nodes of cluster [o-12345678] are running, waiting for 2 running nodes"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error: got %q, want it to contain %q", err, want)
+	}
+}
+
+func TestAwaitOceanNodes_GKE(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/ocean/gcp/k8s/cluster/o-12345678/nodes",
+			map[string]interface{}{"nodeName": "gke-node-1", "status": "READY"},
+			map[string]interface{}{"nodeName": "gke-node-2", "status": "RUNNING"},
+			map[string]interface{}{"nodeName": "gke-node-3", "status": "STOPPING"},
+			map[string]interface{}{"nodeName": "gke-node-4"})
+
+	running, total, err := readOceanGKENodes(api.Client(), "o-12345678")(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if running != 2 || total != 4 {
+		t.Errorf("nodes: got %d of %d running, want 2 of 4", running, total)
+	}
+}
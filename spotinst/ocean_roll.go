@@ -0,0 +1,264 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/util/uritemplates"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// oceanRollStatus is a cloud-agnostic view of an Ocean roll, used while
+// waiting for a roll to make progress.
+type oceanRollStatus struct {
+	ID           string
+	Status       string
+	Progress     float64
+	CurrentBatch int
+	NumOfBatches int
+}
+
+// oceanRollStatusReader fetches the current status of a single roll.
+type oceanRollStatusReader func(ctx context.Context) (*oceanRollStatus, error)
+
+// getOceanRollWaitConfig returns the `wait_for_roll_percentage` and
+// `wait_for_roll_timeout` values configured in a roll_config block.
+func getOceanRollWaitConfig(rollConfig interface{}, pctField, timeoutField commons.FieldName) (float64, int) {
+	var pct float64
+	var timeout int
+
+	if list, ok := rollConfig.([]interface{}); ok && len(list) > 0 && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(pctField)].(float64); ok {
+			pct = v
+		}
+
+		if v, ok := m[string(timeoutField)].(int); ok {
+			timeout = v
+		}
+	}
+
+	return pct, timeout
+}
+
+// getOceanConditionedRollParams returns the values of a list of attributes,
+// e.g. `conditioned_roll_params`, configured in an update_policy block.
+func getOceanConditionedRollParams(resourceData *schema.ResourceData, updatePolicyField, paramsField commons.FieldName) []interface{} {
+	if updatePolicy, exists := resourceData.GetOkExists(string(updatePolicyField)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+			if params, ok := m[string(paramsField)].([]interface{}); ok {
+				return params
+			}
+		}
+	}
+	return nil
+}
+
+// awaitOceanRoll polls the status of a roll until it reaches pctComplete, or
+// fails with an error if the roll failed, was stopped, or did not progress
+// enough within timeout seconds.
+func awaitOceanRoll(ctx context.Context, clusterID, rollID string, pctComplete float64, timeout int, read oceanRollStatusReader) error {
+	log.Printf("awaitOceanRoll() Waiting for roll %s of cluster: %s", rollID, clusterID)
+
+	if timeout <= 0 || pctComplete <= 0 {
+		return fmt.Errorf("invalid timeout/complete durations: timeout=%d, complete=%f", timeout, pctComplete)
+	}
+	if rollID == "" {
+		return fmt.Errorf("invalid roll id: %s", rollID)
+	}
+
+	var rollFailure error
+	err := resource.RetryContext(ctx, time.Second*time.Duration(timeout), func() *resource.RetryError {
+		status, err := read(ctx)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("call to roll status of cluster %q failed: %v", clusterID, err))
+		}
+
+		switch strings.ToUpper(status.Status) {
+		case "FAILED", "STOPPED":
+			rollFailure = fmt.Errorf("roll %q of cluster %q is %s at batch %d of %d (%.0f%% complete)",
+				rollID, clusterID, strings.ToLower(status.Status), status.CurrentBatch, status.NumOfBatches, status.Progress)
+			return resource.NonRetryableError(rollFailure)
+		case "COMPLETED":
+			return nil
+		}
+
+		if status.Progress < pctComplete {
+			log.Printf("awaitOceanRoll() Waiting for at least %f%% of batches to complete, current status: %f%%",
+				pctComplete, status.Progress)
+
+			return resource.RetryableError(fmt.Errorf("roll at %v%% complete", status.Progress))
+		}
+
+		return nil
+	})
+	if rollFailure != nil {
+		return rollFailure
+	}
+	if err != nil {
+		return fmt.Errorf("roll %q of cluster %q did not reach target deployment amount: %v", rollID, clusterID, err)
+	}
+
+	log.Printf("awaitOceanRoll() Target deployment percentage reached for roll %s of cluster: %s", rollID, clusterID)
+	return nil
+}
+
+func readOceanAWSRollStatus(spotinstClient *Client, clusterID, rollID string) oceanRollStatusReader {
+	return func(ctx context.Context) (*oceanRollStatus, error) {
+		out, err := spotinstClient.ocean.CloudProviderAWS().ReadRoll(ctx, &aws.ReadRollInput{
+			ClusterID: spotinst.String(clusterID),
+			RollID:    spotinst.String(rollID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if out.Roll == nil {
+			return nil, fmt.Errorf("roll %q not found", rollID)
+		}
+
+		status := &oceanRollStatus{
+			ID:           spotinst.StringValue(out.Roll.ID),
+			Status:       spotinst.StringValue(out.Roll.Status),
+			CurrentBatch: spotinst.IntValue(out.Roll.CurrentBatch),
+			NumOfBatches: spotinst.IntValue(out.Roll.NumOfBatches),
+		}
+		if out.Roll.Progress != nil {
+			status.Progress = spotinst.Float64Value(out.Roll.Progress.Value)
+		}
+		return status, nil
+	}
+}
+
+func readOceanAKSRollStatus(spotinstClient *Client, clusterID, rollID string) oceanRollStatusReader {
+	return func(ctx context.Context) (*oceanRollStatus, error) {
+		out, err := spotinstClient.ocean.CloudProviderAzureNP().ReadRoll(ctx, &azure_np.ReadRollInput{
+			ClusterID: spotinst.String(clusterID),
+			RollID:    spotinst.String(rollID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if out.Roll == nil {
+			return nil, fmt.Errorf("roll %q not found", rollID)
+		}
+
+		status := &oceanRollStatus{
+			ID:           spotinst.StringValue(out.Roll.ID),
+			Status:       spotinst.StringValue(out.Roll.Status),
+			CurrentBatch: spotinst.IntValue(out.Roll.CurrentBatch),
+			NumOfBatches: spotinst.IntValue(out.Roll.NumOfBatches),
+		}
+		if out.Roll.Progress != nil {
+			status.Progress = spotinst.Float64Value(out.Roll.Progress.ProgressPercentage)
+		}
+		return status, nil
+	}
+}
+
+// The SDK does not expose a read roll operation for GKE and ECS clusters, so
+// their status is fetched directly using the underlying API client.
+
+func readOceanGKERollStatus(spotinstClient *Client, clusterID, rollID string) oceanRollStatusReader {
+	return func(ctx context.Context) (*oceanRollStatus, error) {
+		svc, ok := spotinstClient.ocean.CloudProviderGCP().(*gcp.ServiceOp)
+		if !ok {
+			return nil, fmt.Errorf("unsupported ocean/gcp service implementation")
+		}
+
+		path, err := uritemplates.Expand("/ocean/gcp/k8s/cluster/{clusterId}/roll/{rollId}", uritemplates.Values{
+			"clusterId": clusterID,
+			"rollId":    rollID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		roll := new(gcp.RollStatus)
+		if err := readOceanRollItem(ctx, svc.Client, path, roll); err != nil {
+			return nil, err
+		}
+
+		status := &oceanRollStatus{
+			ID:           spotinst.StringValue(roll.RollID),
+			Status:       spotinst.StringValue(roll.Status),
+			CurrentBatch: spotinst.IntValue(roll.BatchNumber),
+			NumOfBatches: spotinst.IntValue(roll.NumOfBatches),
+		}
+		if roll.Progress != nil {
+			status.Progress = spotinst.Float64Value(roll.Progress.Value)
+		}
+		return status, nil
+	}
+}
+
+func readOceanECSRollStatus(spotinstClient *Client, clusterID, rollID string) oceanRollStatusReader {
+	return func(ctx context.Context) (*oceanRollStatus, error) {
+		svc, ok := spotinstClient.ocean.CloudProviderAWS().(*aws.ServiceOp)
+		if !ok {
+			return nil, fmt.Errorf("unsupported ocean/aws service implementation")
+		}
+
+		path, err := uritemplates.Expand("/ocean/aws/ecs/cluster/{clusterId}/roll/{rollId}", uritemplates.Values{
+			"clusterId": clusterID,
+			"rollId":    rollID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		roll := new(aws.ECSRollClusterStatus)
+		if err := readOceanRollItem(ctx, svc.Client, path, roll); err != nil {
+			return nil, err
+		}
+
+		status := &oceanRollStatus{
+			ID:           spotinst.StringValue(roll.RollID),
+			Status:       spotinst.StringValue(roll.RollStatus),
+			CurrentBatch: spotinst.IntValue(roll.CurrentBatch),
+			NumOfBatches: spotinst.IntValue(roll.NumOfBatches),
+		}
+		if roll.Progress != nil {
+			status.Progress = float64(spotinst.IntValue(roll.Progress.Value))
+		}
+		return status, nil
+	}
+}
+
+func readOceanRollItem(ctx context.Context, c *client.Client, path string, out interface{}) error {
+	resp, err := client.RequireOK(c.Do(ctx, client.NewRequest(http.MethodGet, path)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var rw client.Response
+	if err := json.Unmarshal(body, &rw); err != nil {
+		return err
+	}
+	if len(rw.Response.Items) == 0 {
+		return fmt.Errorf("empty roll status response")
+	}
+
+	return json.Unmarshal(rw.Response.Items[0], out)
+}
@@ -0,0 +1,266 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func TestRollOceanECSLaunchSpec(t *testing.T) {
+	api := newFakeAPI(t)
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSpotinstOceanECSLaunchSpec().Schema, map[string]interface{}{
+		"ocean_id": "o-12345678",
+		"name":     "spec",
+		"update_policy": []interface{}{
+			map[string]interface{}{
+				"should_roll": true,
+				"roll_config": []interface{}{
+					map[string]interface{}{
+						"batch_size_percentage":        20,
+						"batch_min_healthy_percentage": 80,
+					},
+				},
+			},
+		},
+	})
+	resourceData.SetId("ols-12345678")
+
+	if err := rollOceanECSLaunchSpec(resourceData, api.Client()); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := api.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("requests: got %d, want 1", len(requests))
+	}
+	roll := requests[0]
+	if roll.Method != http.MethodPost || roll.Path != "/ocean/aws/ecs/cluster/o-12345678/roll" {
+		t.Fatalf("roll request: got %s %s", roll.Method, roll.Path)
+	}
+
+	body, _ := roll.Body["roll"].(map[string]interface{})
+	if got, want := body["launchSpecIds"], []interface{}{"ols-12345678"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("launchSpecIds: got %v, want %v", got, want)
+	}
+	if got := body["batchSizePercentage"]; got != 20.0 {
+		t.Errorf("batchSizePercentage: got %v, want 20", got)
+	}
+	if got := body["batchMinHealthyPercentage"]; got != 80.0 {
+		t.Errorf("batchMinHealthyPercentage: got %v, want 80", got)
+	}
+}
+
+func TestOceanConditionedRollFields(t *testing.T) {
+	cases := []struct {
+		fields   []string
+		want     []string
+		excluded []string
+	}{
+		{
+			fields:   commons.OceanAWSResource.ConditionedRollFields(),
+			want:     []string{"image_id", "load_balancers", "subnet_ids", "user_data", "whitelist"},
+			excluded: []string{"tags"},
+		},
+		{
+			fields:   commons.OceanAKSNPVirtualNodeGroupResource.ConditionedRollFields(),
+			want:     []string{"kubernetes_version", "labels", "tags", "taints"},
+			excluded: []string{"ocean_id"},
+		},
+		{
+			fields:   commons.OceanECSResource.ConditionedRollFields(),
+			want:     []string{"image_id", "optimize_images", "security_group_ids"},
+			excluded: []string{"tags"},
+		},
+		{
+			fields:   commons.OceanGKEResource.ConditionedRollFields(),
+			want:     []string{"backend_services", "labels", "network_interface", "shielded_instance_config", "source_image"},
+			excluded: []string{"max_size"},
+		},
+		{
+			fields:   commons.OceanGKEImportResource.ConditionedRollFields(),
+			want:     []string{"backend_services", "root_volume_type", "whitelist"},
+			excluded: []string{"shielded_instance_config"},
+		},
+	}
+
+	for _, c := range cases {
+		got := make(map[string]bool)
+		for _, field := range c.fields {
+			got[field] = true
+		}
+		for _, field := range c.want {
+			if !got[field] {
+				t.Errorf("%q missing from %v", field, c.fields)
+			}
+		}
+		for _, field := range c.excluded {
+			if got[field] {
+				t.Errorf("%q unexpected in %v", field, c.fields)
+			}
+		}
+	}
+}
+
+func TestOceanConditionedRollParams_Validation(t *testing.T) {
+	r := resourceSpotinstOceanAWSLaunchSpec()
+
+	diff := func(key, param string) error {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"ocean_id": "o-12345678",
+			"update_policy": []interface{}{
+				map[string]interface{}{
+					"should_roll":      true,
+					"conditioned_roll": true,
+					key:                []interface{}{param},
+				},
+			},
+		}), nil)
+		return err
+	}
+
+	for _, key := range []string{"conditioned_roll_params", "conditioned_roll_exclude_params"} {
+		if err := diff(key, "labels"); err != nil {
+			t.Errorf("%s: labels: unexpected error: %v", key, err)
+		}
+		if err := diff(key, "no_such_field"); err == nil || !strings.Contains(err.Error(), key+`: "no_such_field"`) {
+			t.Errorf("%s: no_such_field: got %v, want an error", key, err)
+		}
+	}
+}
+
+func TestOceanRollConfig_ValidateDiff(t *testing.T) {
+	api := newFakeAPI(t)
+
+	updatePolicy := func(m map[string]interface{}) map[string]interface{} {
+		m["batch_size_percentage"] = 20
+		return map[string]interface{}{"update_policy": []interface{}{map[string]interface{}{
+			"should_roll": true,
+			"roll_config": []interface{}{m},
+		}}}
+	}
+
+	resources := map[string]*schema.Resource{
+		"ocean_ecs":             resourceSpotinstOceanECS(),
+		"ocean_ecs_launch_spec": resourceSpotinstOceanECSLaunchSpec(),
+		"ocean_aws_launch_spec": resourceSpotinstOceanAWSLaunchSpec(),
+		"ocean_aks_np":          resourceSpotinstOceanAKSNP(),
+		"ocean_gke":             resourceSpotinstOceanGKE(),
+		"ocean_gke_import":      resourceSpotinstOceanGKEImport(),
+	}
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		want   string
+	}{
+		{name: "valid wait config",
+			config: updatePolicy(map[string]interface{}{"wait_for_roll_percentage": 50, "wait_for_roll_timeout": 900})},
+		{name: "wait for roll percentage without timeout",
+			config: updatePolicy(map[string]interface{}{"wait_for_roll_percentage": 50}),
+			want:   "wait_for_roll_timeout\" must be set together with"},
+		{name: "wait for roll timeout without percentage",
+			config: updatePolicy(map[string]interface{}{"wait_for_roll_timeout": 900}),
+			want:   "wait_for_roll_percentage\" must be set together with"},
+		{name: "wait for roll percentage out of range",
+			config: updatePolicy(map[string]interface{}{"wait_for_roll_percentage": 150, "wait_for_roll_timeout": 900}),
+			want:   "must be between 0 and 100, got: 150"},
+	}
+
+	for name, r := range resources {
+		for _, tc := range cases {
+			t.Run(name+"/"+tc.name, func(t *testing.T) {
+				_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), api.Client())
+				if tc.want == "" && err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if tc.want != "" && (err == nil || !strings.Contains(err.Error(), tc.want)) {
+					t.Errorf("got %v, want an error containing %q", err, tc.want)
+				}
+			})
+		}
+	}
+}
+
+func TestOceanAWSConditionedRollParams_Override(t *testing.T) {
+	r := resourceSpotinstOceanAWS()
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		params []interface{}
+		want   bool
+	}{
+		{name: "predefined attribute",
+			config: map[string]interface{}{"subnet_ids": []interface{}{"subnet-12345678"}},
+			want:   true},
+		{name: "predefined attribute not listed in params",
+			config: map[string]interface{}{"subnet_ids": []interface{}{"subnet-12345678"}},
+			params: []interface{}{"tags"}},
+		{name: "attribute listed in params",
+			config: map[string]interface{}{"tags": []interface{}{map[string]interface{}{"key": "k", "value": "v"}}},
+			params: []interface{}{"tags"},
+			want:   true},
+		{name: "attribute not requiring a roll",
+			config: map[string]interface{}{"tags": []interface{}{map[string]interface{}{"key": "k", "value": "v"}}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+			_, roll, _, _, err := commons.OceanAWSResource.OnUpdate(resourceData, nil, tc.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if roll != tc.want {
+				t.Errorf("roll: got %v, want %v", roll, tc.want)
+			}
+		})
+	}
+}
+
+func TestOceanConditionedRollExcludeParams(t *testing.T) {
+	r := resourceSpotinstOceanAWSLaunchSpec()
+
+	cases := []struct {
+		name    string
+		config  map[string]interface{}
+		params  []interface{}
+		exclude []interface{}
+		want    bool
+	}{
+		{name: "predefined attribute",
+			config: map[string]interface{}{"user_data": "echo hello"},
+			want:   true},
+		{name: "excluded predefined attribute",
+			config:  map[string]interface{}{"user_data": "echo hello"},
+			exclude: []interface{}{"user_data"}},
+		{name: "other predefined attribute",
+			config:  map[string]interface{}{"image_id": "ami-12345678"},
+			exclude: []interface{}{"user_data"},
+			want:    true},
+		{name: "attribute both listed in params and excluded",
+			config:  map[string]interface{}{"name": "vng"},
+			params:  []interface{}{"name"},
+			exclude: []interface{}{"name"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceData := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+			_, roll, _, err := commons.OceanAWSLaunchSpecResource.OnUpdate(resourceData, nil, tc.params, tc.exclude)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if roll != tc.want {
+				t.Errorf("roll: got %v, want %v", roll, tc.want)
+			}
+		})
+	}
+}
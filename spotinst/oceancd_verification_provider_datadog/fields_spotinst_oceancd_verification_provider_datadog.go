@@ -26,13 +26,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(ApiKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(AppKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 				},
 			},
@@ -43,7 +45,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var result []interface{} = nil
 
 			if verificationProvider != nil && verificationProvider.DataDog != nil {
-				result = flattenDataDog(verificationProvider.DataDog)
+				result = commons.KeepSensitiveValues(resourceData, string(Datadog),
+					flattenDataDog(verificationProvider.DataDog), ApiKey, AppKey)
 			}
 			if len(result) > 0 {
 				if err := resourceData.Set(string(Datadog), result); err != nil {
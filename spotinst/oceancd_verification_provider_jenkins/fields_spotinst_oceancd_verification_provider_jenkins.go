@@ -21,8 +21,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(ApiToken): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(BaseUrl): {
@@ -43,7 +44,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var result []interface{} = nil
 
 			if verificationProvider != nil && verificationProvider.Jenkins != nil {
-				result = flattenJenkins(verificationProvider.Jenkins)
+				result = commons.KeepSensitiveValues(resourceData, string(Jenkins),
+					flattenJenkins(verificationProvider.Jenkins), ApiToken)
 			}
 			if len(result) > 0 {
 				if err := resourceData.Set(string(Jenkins), result); err != nil {
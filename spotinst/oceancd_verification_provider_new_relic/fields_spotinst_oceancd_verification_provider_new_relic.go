@@ -36,8 +36,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(PersonalApiKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
 					},
 
 					string(Region): {
@@ -53,7 +54,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var result []interface{} = nil
 
 			if verificationProvider != nil && verificationProvider.NewRelic != nil {
-				result = flattenNewRelic(verificationProvider.NewRelic)
+				result = commons.KeepSensitiveValues(resourceData, string(NewRelic),
+					flattenNewRelic(verificationProvider.NewRelic), PersonalApiKey)
 			}
 			if len(result) > 0 {
 				if err := resourceData.Set(string(NewRelic), result); err != nil {
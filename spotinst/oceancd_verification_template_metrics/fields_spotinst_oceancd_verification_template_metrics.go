@@ -685,18 +685,6 @@ func expandBaselineProvider(data interface{}) (*oceancd.Provider, error) {
 			provider.SetPrometheus(nil)
 		}
 	}
-
-	if v, ok := m[string(Job)]; ok {
-		job, err := expandJob(v)
-		if err != nil {
-			return nil, err
-		}
-		if job != nil {
-			provider.SetJob(job)
-		} else {
-			provider.SetJob(nil)
-		}
-	}
 	return provider, nil
 }
 
@@ -838,6 +826,17 @@ func expandProvider(data interface{}) (*oceancd.Provider, error) {
 			provider.SetWeb(nil)
 		}
 	}
+	if v, ok := m[string(Job)]; ok {
+		job, err := expandJob(v)
+		if err != nil {
+			return nil, err
+		}
+		if job != nil {
+			provider.SetJob(job)
+		} else {
+			provider.SetJob(nil)
+		}
+	}
 	return provider, nil
 }
 
@@ -977,7 +976,7 @@ func expandCloudWatch(data interface{}) (*oceancd.CloudWatchProvider, error) {
 	}
 	m := list[0].(map[string]interface{})
 
-	if v, ok := m[string(CloudWatchDuration)].(string); ok && v != "" {
+	if v, ok := m[string(Duration)].(string); ok && v != "" {
 		cloudWatch.SetDuration(spotinst.String(v))
 	}
 
@@ -1408,7 +1407,7 @@ func flattenDatadog(datadog *oceancd.DataDogProvider) []interface{} {
 
 func flattenNewRelic(newRelic *oceancd.NewRelicProvider) []interface{} {
 	result := make(map[string]interface{})
-	result[string(Duration)] = spotinst.StringValue(newRelic.Profile)
+	result[string(Profile)] = spotinst.StringValue(newRelic.Profile)
 	result[string(NewRelicQuery)] = spotinst.StringValue(newRelic.Query)
 	return []interface{}{result}
 }
@@ -1455,7 +1454,7 @@ func flattenWeb(web *oceancd.Web) []interface{} {
 	result[string(Body)] = spotinst.StringValue(web.Body)
 	result[string(JsonPath)] = spotinst.StringValue(web.JsonPath)
 	result[string(Method)] = spotinst.StringValue(web.Method)
-	result[string(Url)] = spotinst.StringValue(web.Method)
+	result[string(Url)] = spotinst.StringValue(web.Url)
 
 	result[string(Insecure)] = spotinst.BoolValue(web.Insecure)
 
@@ -1485,7 +1484,7 @@ func flattenHeaders(headers []*oceancd.Headers) []interface{} {
 
 func flattenCloudWatch(cloudWatch *oceancd.CloudWatchProvider) []interface{} {
 	result := make(map[string]interface{})
-	result[string(MetricDataQueries)] = spotinst.StringValue(cloudWatch.Duration)
+	result[string(Duration)] = spotinst.StringValue(cloudWatch.Duration)
 
 	if cloudWatch.MetricDataQueries != nil {
 		result[string(MetricDataQueries)] = flattenMetricDataQueries(cloudWatch.MetricDataQueries)
@@ -0,0 +1,11 @@
+package organization_attachment
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	UserID             commons.FieldName = "user_id"
+	ProgrammaticUserID commons.FieldName = "programmatic_user_id"
+	UserGroupID        commons.FieldName = "user_group_id"
+	PolicyID           commons.FieldName = "policy_id"
+	AccountIDs         commons.FieldName = "account_ids"
+)
@@ -0,0 +1,118 @@
+package organization_attachment
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// SetupUserGroupMembership sets up the fields of the membership of a user in
+// a user group, which is held by the user group.
+func SetupUserGroupMembership(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	setupIDField(fieldsMap, UserGroupID, true)
+	setupIDField(fieldsMap, UserID, false)
+}
+
+// SetupUserPolicyAttachment sets up the fields of a policy attached to a user.
+func SetupUserPolicyAttachment(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	setupIDField(fieldsMap, UserID, true)
+	setupIDField(fieldsMap, PolicyID, false)
+	setupAccountIDsField(fieldsMap)
+}
+
+// SetupProgrammaticUserPolicyAttachment sets up the fields of a policy
+// attached to a programmatic user.
+func SetupProgrammaticUserPolicyAttachment(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	setupIDField(fieldsMap, ProgrammaticUserID, true)
+	setupIDField(fieldsMap, PolicyID, false)
+	setupAccountIDsField(fieldsMap)
+}
+
+// SetupUserGroupPolicyAttachment sets up the fields of a policy attached to a
+// user group.
+func SetupUserGroupPolicyAttachment(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	setupIDField(fieldsMap, UserGroupID, true)
+	setupIDField(fieldsMap, PolicyID, false)
+	setupAccountIDsField(fieldsMap)
+}
+
+// setupIDField sets up the field holding the ID of either the owner of the
+// mapping, or its target. Changing either of them replaces the mapping.
+func setupIDField(fieldsMap map[commons.FieldName]*commons.GenericField, fieldName commons.FieldName, owner bool) {
+	id := func(attachment *commons.OrgAttachment) **string {
+		if owner {
+			return &attachment.OwnerID
+		}
+		return &attachment.TargetID
+	}
+
+	fieldsMap[fieldName] = commons.NewGenericField(
+		commons.OrganizationAttachment,
+		fieldName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgAttachment)
+			if err := resourceData.Set(string(fieldName), spotinst.StringValue(*id(attachment))); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(fieldName), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgAttachment)
+			if v, ok := resourceData.GetOk(string(fieldName)); ok && v != "" {
+				*id(attachment) = spotinst.String(v.(string))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+}
+
+func setupAccountIDsField(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	fieldsMap[AccountIDs] = commons.NewGenericField(
+		commons.OrganizationAttachment,
+		AccountIDs,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgAttachment)
+			if err := resourceData.Set(string(AccountIDs), attachment.AccountIDs); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(AccountIDs), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgAttachment)
+			attachment.AccountIDs = expandAccountIDs(resourceData.Get(string(AccountIDs)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgAttachment)
+			attachment.AccountIDs = expandAccountIDs(resourceData.Get(string(AccountIDs)))
+			return nil
+		},
+		nil,
+	)
+}
+
+func expandAccountIDs(data interface{}) []string {
+	list, _ := data.([]interface{})
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if accountID, ok := v.(string); ok && accountID != "" {
+			result = append(result, accountID)
+		}
+	}
+	return result
+}
@@ -0,0 +1,384 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// orgMapping is a single entry of the mappings held by an organization object,
+// e.g. a user of a user group, or a policy attached to a user.
+type orgMapping struct {
+	TargetID   string
+	AccountIDs []string
+}
+
+// orgAttachmentKind describes how the mappings of an owner object are read
+// and written. The API only replaces the full list of mappings, so attaching
+// or detaching a single one reads the list, changes it and writes it back.
+type orgAttachmentKind struct {
+	resource func() *commons.OrgAttachmentTerraformResource
+
+	// read returns the mappings of the owner, or false if it does not exist.
+	read func(ctx context.Context, spotinstClient *Client, ownerID string) ([]orgMapping, bool, error)
+
+	// write replaces the mappings of the owner.
+	write func(ctx context.Context, spotinstClient *Client, ownerID string, mappings []orgMapping) error
+}
+
+// orgAttachmentLocks serializes the changes to the mappings of each owner, so
+// attachments to the same owner applied in parallel do not overwrite each
+// other.
+var orgAttachmentLocks sync.Map
+
+func lockOrgAttachmentOwner(ownerID string) func() {
+	mu, _ := orgAttachmentLocks.LoadOrStore(ownerID, new(sync.Mutex))
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+func orgAttachmentID(ownerID, targetID string) string {
+	return ownerID + ":" + targetID
+}
+
+func parseOrgAttachmentID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID %q, expected the form <owner_id>:<target_id>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (kind *orgAttachmentKind) schemaResource() *schema.Resource {
+	res := &schema.Resource{
+		CreateContext: kind.create,
+		ReadContext:   kind.readResource,
+		DeleteContext: kind.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema:   kind.resource().GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
+	}
+
+	// Mappings with nothing but the IDs of their objects are replaced on
+	// any change.
+	for _, s := range res.Schema {
+		if !s.ForceNew && !s.Computed {
+			res.UpdateContext = kind.update
+			break
+		}
+	}
+	return res
+}
+
+func (kind *orgAttachmentKind) create(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	res := kind.resource()
+	log.Printf(string(commons.ResourceOnCreate), res.GetName())
+
+	attachment, err := res.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ownerID, targetID := spotinst.StringValue(attachment.OwnerID), spotinst.StringValue(attachment.TargetID)
+	if err := kind.attach(ctx, meta.(*Client), ownerID, orgMapping{TargetID: targetID, AccountIDs: attachment.AccountIDs}); err != nil {
+		return diag.Errorf("[ERROR] Failed to create %s: %s", res.GetName(), err)
+	}
+
+	resourceData.SetId(orgAttachmentID(ownerID, targetID))
+	log.Printf("===> %s created successfully: %s <===", res.GetName(), resourceData.Id())
+
+	return kind.readResource(ctx, resourceData, meta)
+}
+
+func (kind *orgAttachmentKind) readResource(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	res := kind.resource()
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), res.GetName(), id)
+
+	ownerID, targetID, err := parseOrgAttachmentID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mappings, found, err := kind.read(ctx, meta.(*Client), ownerID)
+	if err != nil {
+		return diag.Errorf("[ERROR] Failed to read %s: %s", res.GetName(), err)
+	}
+
+	// If either the owner or the mapping is gone, then return no state.
+	i := findOrgMapping(mappings, targetID)
+	if !found || i < 0 {
+		resourceData.SetId("")
+		return nil
+	}
+
+	attachment := &commons.OrgAttachment{
+		OwnerID:    spotinst.String(ownerID),
+		TargetID:   spotinst.String(targetID),
+		AccountIDs: mappings[i].AccountIDs,
+	}
+	if err := res.OnRead(attachment, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("===> %s read successfully: %s <===", res.GetName(), id)
+	return nil
+}
+
+func (kind *orgAttachmentKind) update(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	res := kind.resource()
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate), res.GetName(), id)
+
+	shouldUpdate, attachment, err := res.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if shouldUpdate {
+		ownerID, targetID, err := parseOrgAttachmentID(id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := kind.attach(ctx, meta.(*Client), ownerID, orgMapping{TargetID: targetID, AccountIDs: attachment.AccountIDs}); err != nil {
+			return diag.Errorf("[ERROR] Failed to update %s: %s", res.GetName(), err)
+		}
+	}
+
+	log.Printf("===> %s updated successfully: %s <===", res.GetName(), id)
+	return kind.readResource(ctx, resourceData, meta)
+}
+
+func (kind *orgAttachmentKind) delete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	res := kind.resource()
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete), res.GetName(), id)
+
+	ownerID, targetID, err := parseOrgAttachmentID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := kind.detach(ctx, meta.(*Client), ownerID, targetID); err != nil {
+		return diag.Errorf("[ERROR] Failed to delete %s: %s", res.GetName(), err)
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+// attach adds a mapping to the owner, or replaces the existing mapping of the
+// same target, leaving the other mappings of the owner untouched.
+func (kind *orgAttachmentKind) attach(ctx context.Context, spotinstClient *Client, ownerID string, mapping orgMapping) error {
+	unlock := lockOrgAttachmentOwner(ownerID)
+	defer unlock()
+
+	mappings, found, err := kind.read(ctx, spotinstClient, ownerID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%s does not exist", ownerID)
+	}
+
+	if i := findOrgMapping(mappings, mapping.TargetID); i >= 0 {
+		mappings[i] = mapping
+	} else {
+		mappings = append(mappings, mapping)
+	}
+	return kind.write(ctx, spotinstClient, ownerID, mappings)
+}
+
+// detach removes the mapping of a target from the owner, leaving the other
+// mappings of the owner untouched.
+func (kind *orgAttachmentKind) detach(ctx context.Context, spotinstClient *Client, ownerID, targetID string) error {
+	unlock := lockOrgAttachmentOwner(ownerID)
+	defer unlock()
+
+	mappings, found, err := kind.read(ctx, spotinstClient, ownerID)
+	if err != nil {
+		return err
+	}
+
+	i := findOrgMapping(mappings, targetID)
+	if !found || i < 0 {
+		return nil
+	}
+	return kind.write(ctx, spotinstClient, ownerID, append(mappings[:i], mappings[i+1:]...))
+}
+
+func findOrgMapping(mappings []orgMapping, targetID string) int {
+	for i, mapping := range mappings {
+		if mapping.TargetID == targetID {
+			return i
+		}
+	}
+	return -1
+}
+
+// isOrgObjectNotFound reports whether the error is returned for an
+// organization object that does not exist.
+func isOrgObjectNotFound(err error) bool {
+	if errs, ok := err.(client.Errors); ok {
+		for _, err := range errs {
+			if err.Code == ErrCodeResourceDoesNotExist {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func readOrgUserGroupUsers(ctx context.Context, spotinstClient *Client, ownerID string) ([]orgMapping, bool, error) {
+	userGroup, err := readOrgUserGroupForAttachment(ctx, spotinstClient, ownerID)
+	if err != nil || userGroup == nil {
+		return nil, false, err
+	}
+
+	// The users of a group are returned in either of the attributes.
+	var mappings []orgMapping
+	add := func(userID string) {
+		if userID != "" && findOrgMapping(mappings, userID) < 0 {
+			mappings = append(mappings, orgMapping{TargetID: userID})
+		}
+	}
+	for _, userID := range userGroup.UserIds {
+		add(userID)
+	}
+	for _, user := range userGroup.Users {
+		add(spotinst.StringValue(user.UserId))
+	}
+	return mappings, true, nil
+}
+
+func writeOrgUserGroupUsers(ctx context.Context, spotinstClient *Client, ownerID string, mappings []orgMapping) error {
+	userIDs := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		userIDs = append(userIDs, mapping.TargetID)
+	}
+	return putOrgMappings(ctx, spotinstClient,
+		fmt.Sprintf("/setup/access/userGroup/%s/userMapping", ownerID),
+		map[string]interface{}{"userIds": userIDs})
+}
+
+func readOrgUserGroupPolicies(ctx context.Context, spotinstClient *Client, ownerID string) ([]orgMapping, bool, error) {
+	userGroup, err := readOrgUserGroupForAttachment(ctx, spotinstClient, ownerID)
+	if err != nil || userGroup == nil {
+		return nil, false, err
+	}
+
+	mappings := make([]orgMapping, 0, len(userGroup.Policies))
+	for _, policy := range userGroup.Policies {
+		mappings = append(mappings, orgMapping{TargetID: spotinst.StringValue(policy.PolicyId), AccountIDs: policy.AccountIds})
+	}
+	return mappings, true, nil
+}
+
+func writeOrgUserGroupPolicies(ctx context.Context, spotinstClient *Client, ownerID string, mappings []orgMapping) error {
+	return putOrgMappings(ctx, spotinstClient,
+		fmt.Sprintf("/setup/access/userGroup/%s/policyMapping", ownerID),
+		map[string]interface{}{"policies": flattenOrgPolicyMappings(mappings)})
+}
+
+func readOrgUserPolicies(ctx context.Context, spotinstClient *Client, ownerID string) ([]orgMapping, bool, error) {
+	output, err := spotinstClient.organization.ReadUser(ctx, &organization.ReadUserInput{UserID: spotinst.String(ownerID)})
+	if err != nil {
+		if isOrgObjectNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if output.User == nil {
+		return nil, false, nil
+	}
+
+	mappings := make([]orgMapping, 0, len(output.User.Policies))
+	for _, policy := range output.User.Policies {
+		mappings = append(mappings, orgMapping{TargetID: spotinst.StringValue(policy.PolicyId), AccountIDs: policy.AccountIds})
+	}
+	return mappings, true, nil
+}
+
+func readOrgProgrammaticUserPolicies(ctx context.Context, spotinstClient *Client, ownerID string) ([]orgMapping, bool, error) {
+	output, err := spotinstClient.organization.ReadProgUser(ctx, &organization.ReadUserInput{UserID: spotinst.String(ownerID)})
+	if err != nil {
+		if isOrgObjectNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if output.ProgUser == nil {
+		return nil, false, nil
+	}
+
+	mappings := make([]orgMapping, 0, len(output.ProgUser.Policies))
+	for _, policy := range output.ProgUser.Policies {
+		mappings = append(mappings, orgMapping{TargetID: spotinst.StringValue(policy.PolicyId), AccountIDs: policy.AccountIds})
+	}
+	return mappings, true, nil
+}
+
+// writeOrgUserPolicies replaces the policies of either a user or a
+// programmatic user, which share the same endpoint.
+func writeOrgUserPolicies(ctx context.Context, spotinstClient *Client, ownerID string, mappings []orgMapping) error {
+	return putOrgMappings(ctx, spotinstClient,
+		fmt.Sprintf("/setup/user/%s/policyMapping", ownerID),
+		map[string]interface{}{"policies": flattenOrgPolicyMappings(mappings)})
+}
+
+func readOrgUserGroupForAttachment(ctx context.Context, spotinstClient *Client, userGroupID string) (*organization.UserGroup, error) {
+	output, err := spotinstClient.organization.ReadUserGroup(ctx, &organization.ReadUserGroupInput{UserGroupID: spotinst.String(userGroupID)})
+	if err != nil {
+		if isOrgObjectNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return output.UserGroup, nil
+}
+
+func flattenOrgPolicyMappings(mappings []orgMapping) []interface{} {
+	policies := make([]interface{}, 0, len(mappings))
+	for _, mapping := range mappings {
+		accountIDs := mapping.AccountIDs
+		if accountIDs == nil {
+			accountIDs = []string{}
+		}
+		policies = append(policies, map[string]interface{}{
+			"policyId":   mapping.TargetID,
+			"accountIds": accountIDs,
+		})
+	}
+	return policies
+}
+
+// putOrgMappings replaces the mappings of an organization object directly
+// using the underlying API client, as the inputs of the SDK omit empty lists,
+// which are needed to detach the last mapping.
+func putOrgMappings(ctx context.Context, spotinstClient *Client, path string, body map[string]interface{}) error {
+	svc, ok := spotinstClient.organization.(*organization.ServiceOp)
+	if !ok {
+		return fmt.Errorf("unsupported organization service implementation")
+	}
+
+	r := client.NewRequest(http.MethodPut, path)
+	r.Obj = body
+
+	resp, err := client.RequireOK(svc.Client.DoOrg(ctx, r))
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
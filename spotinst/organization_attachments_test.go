@@ -0,0 +1,121 @@
+package spotinst
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOrganizationUserGroupMembership_KeepsOtherMembers(t *testing.T) {
+	api := newFakeAPI(t).
+		Put("/setup/access/userGroup/ugr-12345678", map[string]interface{}{
+			"id":      "ugr-12345678",
+			"userIds": []interface{}{"u-00000001"},
+			"users": []interface{}{
+				map[string]interface{}{"userId": "u-00000002", "type": "PROGRAMMATIC"},
+			},
+		}).
+		Mapping("userMapping")
+	r := resourceOrgUserGroupMembership()
+
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"user_group_id": "ugr-12345678",
+		"user_id":       "u-12345678",
+	})
+	if diags := r.CreateContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+	if got, want := resourceData.Id(), "ugr-12345678:u-12345678"; got != want {
+		t.Errorf("id: got %q, want %q", got, want)
+	}
+
+	group, _ := api.Object("/setup/access/userGroup/ugr-12345678")
+	want := []interface{}{"u-00000001", "u-00000002", "u-12345678"}
+	if got := group["userIds"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("userIds after create: got %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationUserPolicyAttachment_DetachesOnlyItsPolicy(t *testing.T) {
+	api := newFakeAPI(t).
+		Put("/setup/user/u-12345678", map[string]interface{}{
+			"userId": "u-12345678",
+			"policies": []interface{}{
+				map[string]interface{}{"policyId": "3", "policyName": "Account Viewer", "accountIds": []interface{}{"act-1"}},
+				map[string]interface{}{"policyId": "pol-12345678", "accountIds": []interface{}{"act-1"}},
+			},
+		}).
+		Mapping("policyMapping")
+	r := resourceOrgUserPolicyAttachment()
+	client := api.Client()
+
+	resourceData := r.Data(nil)
+	resourceData.SetId("u-12345678:pol-12345678")
+	if diags := r.ReadContext(context.Background(), resourceData, client); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if got := resourceData.Get("account_ids"); !reflect.DeepEqual(got, []interface{}{"act-1"}) {
+		t.Errorf("account_ids: got %v, want [act-1]", got)
+	}
+
+	if diags := r.DeleteContext(context.Background(), resourceData, client); diags.HasError() {
+		t.Fatalf("delete failed: %v", diags)
+	}
+
+	user, _ := api.Object("/setup/user/u-12345678")
+	want := []interface{}{
+		map[string]interface{}{"policyId": "3", "accountIds": []interface{}{"act-1"}},
+	}
+	if got := user["policies"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("policies after delete: got %v, want %v", got, want)
+	}
+
+	// Once detached, the attachment is gone from the state.
+	resourceData.SetId("u-12345678:pol-12345678")
+	if diags := r.ReadContext(context.Background(), resourceData, client); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if resourceData.Id() != "" {
+		t.Errorf("id after delete: got %q, want empty", resourceData.Id())
+	}
+}
+
+func TestOrganizationUserGroupPolicyAttachment_DetachesLastPolicy(t *testing.T) {
+	api := newFakeAPI(t).
+		Put("/setup/access/userGroup/ugr-12345678", map[string]interface{}{
+			"id": "ugr-12345678",
+			"policies": []interface{}{
+				map[string]interface{}{"policyId": "pol-12345678", "accountIds": []interface{}{"act-1"}},
+			},
+		}).
+		Mapping("policyMapping")
+	r := resourceOrgUserGroupPolicyAttachment()
+
+	resourceData := r.Data(nil)
+	resourceData.SetId("ugr-12345678:pol-12345678")
+	if diags := r.DeleteContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("delete failed: %v", diags)
+	}
+
+	// The empty list must be sent, or the API leaves the policies unchanged.
+	requests := api.Requests()
+	last := requests[len(requests)-1]
+	if got, ok := last.Body["policies"]; !ok || !reflect.DeepEqual(got, []interface{}{}) {
+		t.Errorf("policies sent: got %v, want []", got)
+	}
+}
+
+func TestParseOrgAttachmentID(t *testing.T) {
+	owner, target, err := parseOrgAttachmentID("ugr-12345678:u-12345678")
+	if err != nil || owner != "ugr-12345678" || target != "u-12345678" {
+		t.Errorf("got %q, %q, %v", owner, target, err)
+	}
+
+	for _, id := range []string{"", "ugr-12345678", "ugr-12345678:", ":u-12345678", "a:b:c"} {
+		if _, _, err := parseOrgAttachmentID(id); err == nil {
+			t.Errorf("%q: expected an error", id)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package organization_policy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Effects are the effects a policy statement may have.
+var Effects = []string{"ALLOW", "DENY"}
+
+// Sources of the known actions and object IDs, as of 2026-10-18. The full list
+// of actions is documented at
+// https://docs.spot.io/account-user-management/user-management/access-policies-actions/,
+// but only the actions and IDs found in the documents below are known, so that
+// every entry of the catalogue can be traced back to its source.
+const (
+	sourceProviderDocs = "docs/resources/organization_policy.md, applied by TestAccSpotinstOrganization_Policy"
+	sourceSDKExamples  = "spotinst-sdk-go v1.382.0, examples/service"
+)
+
+// documentedActions lists the known actions of policy statements, in the form
+// `<service>:<action>`, with the document each is found in. Only add an action
+// along with its source.
+var documentedActions = []struct {
+	action, source string
+}{
+	{"ocean:createCluster", sourceProviderDocs},
+	{"ocean:deleteCluster", sourceProviderDocs},
+	{"ocean:updateCluster", sourceSDKExamples + "/organization/policy/create"},
+}
+
+// documentedIDPrefixes lists the prefixes of the IDs of the objects a policy
+// statement may be restricted to, with the document each is found in.
+var documentedIDPrefixes = []struct {
+	prefix, source string
+}{
+	{"act", "docs/resources/credentials_aws.md"},
+	{"sig", "docs/resources/elastigroup_aws_suspension.md"},
+	{"o", "docs/resources/ocean_aws_launch_spec.md"},
+	{"ols", "docs/resources/ocean_aws_launch_spec.md"},
+	{"vng", sourceSDKExamples + "/ocean/providers/azure_np/launchNewNodes"},
+	{"ssn", sourceSDKExamples + "/stateful/providers/azure/update"},
+	{"smi", sourceSDKExamples + "/managedinstance/providers/aws/resume"},
+	{"di", "docs/resources/elastigroup_aws.md"},
+	{"pol", "docs/resources/organization_user_group.md"},
+	{"ugr", "docs/resources/organization_user.md"},
+	{"u", "docs/resources/organization_user_group.md"},
+	{"pu", sourceSDKExamples + "/organization/userGroup/updateUserMapping"},
+}
+
+// actionCatalogue holds the known actions of every service.
+var actionCatalogue = newActionCatalogue()
+
+// resourcePattern matches the IDs of the objects a policy statement may be
+// restricted to, optionally ending with a wildcard.
+var resourcePattern = newResourcePattern()
+
+func newActionCatalogue() map[string][]string {
+	catalogue := make(map[string][]string)
+	for _, documented := range documentedActions {
+		parts := strings.SplitN(documented.action, ":", 2)
+		catalogue[parts[0]] = append(catalogue[parts[0]], parts[1])
+	}
+	return catalogue
+}
+
+func newResourcePattern() *regexp.Regexp {
+	prefixes := make([]string, 0, len(documentedIDPrefixes))
+	for _, documented := range documentedIDPrefixes {
+		prefixes = append(prefixes, documented.prefix)
+	}
+	return regexp.MustCompile(`^(` + strings.Join(prefixes, "|") + `)-[0-9a-z]*\*?$`)
+}
+
+// ValidateAction validates an action of a policy statement. Wildcards are
+// supported in both the service and the action. Only malformed actions are
+// rejected. As the catalogue of known actions is not exhaustive, names missing
+// from it only produce a warning when close to a known name, including one
+// differing only by case, which is likely a typo.
+func ValidateAction(v interface{}, k string) (warnings []string, errors []error) {
+	action, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if action == "*" {
+		return nil, nil
+	}
+
+	parts := strings.Split(action, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, []error{fmt.Errorf("%s: invalid action %q, expected the form <service>:<action>, e.g. \"ocean:createCluster\"", k, action)}
+	}
+	service, name := parts[0], parts[1]
+
+	known, ok := actionCatalogue[service]
+	if !ok && service != "*" {
+		if suggestion := closestName(service, catalogueServices()); suggestion != "" {
+			return []string{fmt.Sprintf("%s: service %q of action %q is not in the catalogue of known services, did you mean %q?", k, service, action, suggestion)}, nil
+		}
+	}
+	if !ok || strings.Contains(name, "*") {
+		return nil, nil
+	}
+	for _, a := range known {
+		if a == name {
+			return nil, nil
+		}
+	}
+	if suggestion := closestName(name, known); suggestion != "" {
+		return []string{fmt.Sprintf("%s: action %q is not in the catalogue of known actions, did you mean %q?", k, action, service+":"+suggestion)}, nil
+	}
+	return nil, nil
+}
+
+// ValidateResource validates a resource of a policy statement, which is
+// either a wildcard or the ID of an object, optionally ending with a wildcard.
+func ValidateResource(v interface{}, k string) (warnings []string, errors []error) {
+	resource, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if resource == "" {
+		return nil, []error{fmt.Errorf("%s: resource must not be empty", k)}
+	}
+	if resource == "*" || resourcePattern.MatchString(resource) {
+		return nil, nil
+	}
+	return []string{fmt.Sprintf("%s: resource %q is neither \"*\" nor a known object ID", k, resource)}, nil
+}
+
+func catalogueServices() []string {
+	services := make([]string, 0, len(actionCatalogue))
+	for service := range actionCatalogue {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// closestName returns the candidate closest to a name, when it is close
+// enough to be a possible typo, ignoring case.
+func closestName(name string, candidates []string) string {
+	const maxDistance = 2
+
+	best, bestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
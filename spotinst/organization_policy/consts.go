@@ -10,4 +10,9 @@ const (
 	Actions       commons.FieldName = "actions"
 	Effect        commons.FieldName = "effect"
 	Resources     commons.FieldName = "resources"
+
+	// Policy document data source.
+	Statement             commons.FieldName = "statement"
+	SourcePolicyDocuments commons.FieldName = "source_policy_documents"
+	JSON                  commons.FieldName = "json"
 )
@@ -12,4 +12,6 @@ const (
 	PolicyAccountIds commons.FieldName = "policy_account_ids"
 	PolicyId         commons.FieldName = "policy_id"
 	UserGroupIds     commons.FieldName = "user_group_ids"
+	Token            commons.FieldName = "token"
+	TokenRotation    commons.FieldName = "token_rotation_trigger"
 )
@@ -93,6 +93,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeSet,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(PolicyAccountIds): {
@@ -220,6 +221,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -251,17 +253,48 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			orgProgrammaticUserWrapper := resourceObject.(*commons.OrgProgrammaticUserWrapper)
 			orgProgrammaticUser := orgProgrammaticUserWrapper.GetOrgProgrammaticUser()
-			if value, ok := resourceData.GetOk(string(UserGroupIds)); ok && value != nil {
-				if userGroupIds, err := expandUserGroupIds(value); err != nil {
-					return err
-				} else {
-					orgProgrammaticUser.SetProgUserGroupIds(userGroupIds)
-				}
+			// An empty list is sent as is, to clear the memberships.
+			if userGroupIds, err := expandUserGroupIds(resourceData.Get(string(UserGroupIds))); err != nil {
+				return err
+			} else {
+				orgProgrammaticUser.SetProgUserGroupIds(userGroupIds)
 			}
 			return nil
 		},
 		nil,
 	)
+
+	// The token is only returned when the user is created, so it is set by
+	// the create function and kept as is when reading the user.
+	fieldsMap[Token] = commons.NewGenericField(
+		commons.OrganizationProgrammaticUser,
+		Token,
+		&schema.Schema{
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	// Changing the trigger replaces the user, as the API does not regenerate
+	// the token of an existing user.
+	fieldsMap[TokenRotation] = commons.NewGenericField(
+		commons.OrganizationProgrammaticUser,
+		TokenRotation,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
 }
 
 func expandPolicies(data interface{}) ([]*organization.ProgPolicy, error) {
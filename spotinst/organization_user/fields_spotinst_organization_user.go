@@ -102,9 +102,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.OrganizationUser,
 		Password,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			Computed:  true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			return nil
@@ -163,6 +164,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeSet,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(PolicyAccountIds): {
@@ -229,6 +231,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -260,12 +263,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			orgUserWrapper := resourceObject.(*commons.OrgUserWrapper)
 			orgUser := orgUserWrapper.GetOrgUser()
-			if value, ok := resourceData.GetOk(string(UserGroupIds)); ok {
-				if userGroupIds, err := expandUserGroupIds(value); err != nil {
-					return err
-				} else {
-					orgUser.SetUserGroupIds(userGroupIds)
-				}
+			// An empty list is sent as is, to clear the memberships.
+			if userGroupIds, err := expandUserGroupIds(resourceData.Get(string(UserGroupIds))); err != nil {
+				return err
+			} else {
+				orgUser.SetUserGroupIds(userGroupIds)
 			}
 			return nil
 		},
@@ -93,6 +93,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeList,
 			Optional: true,
+			Computed: true,
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -124,12 +125,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			orgUserGroupWrapper := resourceObject.(*commons.OrgUserGroupWrapper)
 			orgUserGroup := orgUserGroupWrapper.GetOrgUserGroup()
-			if value, ok := resourceData.GetOk(string(UserIds)); ok && value != nil {
-				if userIds, err := expandUserIds(value); err != nil {
-					return err
-				} else {
-					orgUserGroup.SetUserIds(userIds)
-				}
+			// An empty list is sent as is, to clear the memberships.
+			if userIds, err := expandUserIds(resourceData.Get(string(UserIds))); err != nil {
+				return err
+			} else {
+				orgUserGroup.SetUserIds(userIds)
 			}
 			return nil
 		},
@@ -142,6 +142,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeSet,
 			Optional: true,
+			Computed: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(AccountIds): {
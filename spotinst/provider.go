@@ -2,9 +2,14 @@ package spotinst
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 )
 
@@ -20,8 +25,9 @@ func Provider() *schema.Provider {
 			},
 
 			string(commons.ProviderToken): {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
 				//DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarToken, ""),
 				Description: "Spotinst Personal API Access Token",
 			},
@@ -39,6 +45,53 @@ func Provider() *schema.Provider {
 				//DefaultFunc: schema.EnvDefaultFunc(featureflag.EnvVar, ""),
 				Description: "Spotinst SDK Feature Flags",
 			},
+
+			string(commons.ProviderBaseURL): {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(EnvBaseURL, nil),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Address of the Spotinst API",
+			},
+
+			string(commons.ProviderProxyURL): {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(EnvProxyURL, nil),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description:  "Address of the proxy to reach the Spotinst API through",
+			},
+
+			string(commons.ProviderCABundle): {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(EnvCABundle, ""),
+				Description: "Path to a PEM-encoded bundle of certificate authorities to trust, in addition to the system ones",
+			},
+
+			string(commons.ProviderRequestTimeout): {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(EnvRequestTimeout, ""),
+				ValidateFunc: validateDuration,
+				Description:  "Timeout of a single request to the Spotinst API, including its retries, e.g. `30s`",
+			},
+
+			string(commons.ProviderMaxRetries): {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  envIntDefaultFunc(EnvMaxRetries, DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of times a throttled or failed request to the Spotinst API is retried",
+			},
+
+			string(commons.ProviderMaxRetryBackoff): {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(EnvMaxRetryBackoff, DefaultMaxRetryBackoff.String()),
+				ValidateFunc: validateDuration,
+				Description:  "Maximum delay between two attempts of a request to the Spotinst API, e.g. `30s`",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -54,6 +107,7 @@ func Provider() *schema.Provider {
 			// Ocean.
 			string(commons.OceanAWSResourceName):                   resourceSpotinstOceanAWS(),
 			string(commons.OceanAWSLaunchSpecResourceName):         resourceSpotinstOceanAWSLaunchSpec(),
+			string(commons.OceanGKEResourceName):                   resourceSpotinstOceanGKE(),
 			string(commons.OceanGKEImportResourceName):             resourceSpotinstOceanGKEImport(),
 			string(commons.OceanGKELaunchSpecResourceName):         resourceSpotinstOceanGKELaunchSpec(),
 			string(commons.OceanGKELaunchSpecImportResourceName):   resourceSpotinstOceanGKELaunchSpecImport(),
@@ -71,6 +125,9 @@ func Provider() *schema.Provider {
 			// SuspendProcesses
 			string(commons.SuspendProcessesResourceName): resourceSpotinstElastigroupSuspendProcesses(),
 
+			// InstanceOperation
+			string(commons.ElastigroupAWSInstanceOperationResourceName): resourceSpotinstElastigroupAWSInstanceOperation(),
+
 			// ExtendedResourceDefinition
 			string(commons.OceanAWSExtendedResourceDefinitionResourceName): resourceSpotinstOceanAWSExtendedResourceDefinition(),
 
@@ -98,6 +155,12 @@ func Provider() *schema.Provider {
 			// Organization User Group
 			string(commons.OrgUserGroupResourceName): resourceOrgUserGroup(),
 
+			// Organization Attachments
+			string(commons.OrgUserGroupMembershipResourceName):              resourceOrgUserGroupMembership(),
+			string(commons.OrgUserPolicyAttachmentResourceName):             resourceOrgUserPolicyAttachment(),
+			string(commons.OrgProgrammaticUserPolicyAttachmentResourceName): resourceOrgProgrammaticUserPolicyAttachment(),
+			string(commons.OrgUserGroupPolicyAttachmentResourceName):        resourceOrgUserGroupPolicyAttachment(),
+
 			// AWS Account Creation
 			string(commons.AccountAWSResourceName): resourceSpotinstAccountAWS(),
 
@@ -125,6 +188,27 @@ func Provider() *schema.Provider {
 			// Account Creation
 			string(commons.AccountResourceName): resourceSpotinstAccount(),
 		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			// Elastigroup.
+			string(commons.ElastigroupAWSResourceName): dataSourceSpotinstElastigroupAWS(),
+
+			// Ocean.
+			string(commons.OceanAWSResourceName): dataSourceSpotinstOceanAWS(),
+
+			// Organization.
+			string(commons.AccountResourceName):             dataSourceSpotinstAccount(),
+			string(commons.OrgPolicyResourceName):           dataSourceSpotinstOrgPolicy(),
+			string(commons.OrgPolicyDocumentDataSourceName): dataSourceSpotinstOrgPolicyDocument(),
+			string(commons.OrgUserResourceName):             dataSourceSpotinstOrgUser(),
+			string(commons.OrgUserGroupResourceName):        dataSourceSpotinstOrgUserGroup(),
+		},
+	}
+
+	for name, res := range p.ResourcesMap {
+		if !organizationResources[name] {
+			withAccountOverride(res)
+		}
 	}
 
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -140,15 +224,51 @@ func Provider() *schema.Provider {
 	return p
 }
 
+// organizationResources are the resources that belong to the organization,
+// rather than to an account, and so cannot override the provider account.
+var organizationResources = map[string]bool{
+	string(commons.AccountResourceName):             true,
+	string(commons.AccountAWSResourceName):          true,
+	string(commons.OrgPolicyResourceName):           true,
+	string(commons.OrgProgrammaticUserResourceName): true,
+	string(commons.OrgUserResourceName):             true,
+	string(commons.OrgUserGroupResourceName):        true,
+
+	string(commons.OrgUserGroupMembershipResourceName):              true,
+	string(commons.OrgUserPolicyAttachmentResourceName):             true,
+	string(commons.OrgProgrammaticUserPolicyAttachmentResourceName): true,
+	string(commons.OrgUserGroupPolicyAttachmentResourceName):        true,
+}
+
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	config := Config{
 		Enabled:          d.Get(string(commons.ProviderEnabled)).(bool),
 		Token:            d.Get(string(commons.ProviderToken)).(string),
 		Account:          d.Get(string(commons.ProviderAccount)).(string),
 		FeatureFlags:     d.Get(string(commons.ProviderFeatureFlags)).(string),
+		BaseURL:          d.Get(string(commons.ProviderBaseURL)).(string),
+		ProxyURL:         d.Get(string(commons.ProviderProxyURL)).(string),
+		CABundle:         d.Get(string(commons.ProviderCABundle)).(string),
+		MaxRetries:       d.Get(string(commons.ProviderMaxRetries)).(int),
 		terraformVersion: terraformVersion,
 	}
 
+	if v, ok := d.Get(string(commons.ProviderRequestTimeout)).(string); ok && v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config.RequestTimeout = timeout
+	}
+
+	if v, ok := d.Get(string(commons.ProviderMaxRetryBackoff)).(string); ok && v != "" {
+		backoff, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config.MaxRetryBackoff = backoff
+	}
+
 	if config.Enabled == false {
 		return nil, diag.Diagnostics{
 			{
@@ -161,3 +281,29 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 
 	return config.Client()
 }
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	if s, ok := v.(string); ok && s != "" {
+		if d, err := time.ParseDuration(s); err != nil {
+			return nil, []error{fmt.Errorf("%q must be a duration, e.g. 30s: %v", k, err)}
+		} else if d < 0 {
+			return nil, []error{fmt.Errorf("%q must not be negative", k)}
+		}
+	}
+	return nil, nil
+}
+
+// envIntDefaultFunc is like schema.EnvDefaultFunc, but parses the value of the
+// environment variable as an integer.
+func envIntDefaultFunc(k string, dv int) schema.SchemaDefaultFunc {
+	return func() (interface{}, error) {
+		if v := os.Getenv(k); v != "" {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of %s: %v", k, err)
+			}
+			return i, nil
+		}
+		return dv, nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var TestAccProviders map[string]*schema.Provider
@@ -42,6 +43,31 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProvider_ValidateMinimalConfig(t *testing.T) {
+	for _, env := range []string{EnvBaseURL, EnvProxyURL, EnvCABundle, EnvRequestTimeout} {
+		t.Setenv(env, "")
+		os.Unsetenv(env)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"token": "fake-token",
+	})
+	if diags := Provider().Validate(config); diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+}
+
+func TestProvider_ValidateURLs(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"token":     "fake-token",
+		"base_url":  "api.spotinst.io",
+		"proxy_url": "ftp://proxy:8080",
+	})
+	if diags := Provider().Validate(config); len(diags) != 2 {
+		t.Fatalf("expected an error for each URL, got: %v", diags)
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	_ = Provider()
 }
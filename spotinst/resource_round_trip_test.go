@@ -0,0 +1,484 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var roundTripCases = map[string]roundTripCase{
+	"spotinst_credentials_aws": {
+		// The account is only sent as the accountId query parameter.
+		ignore: []string{"account_id"},
+	},
+	"spotinst_credentials_gcp": {
+		setup: func(api *fakeAPI) {
+			api.WrapItems("/gcp/setup/credentials", "serviceAccount")
+		},
+		// The account is only sent as the accountId query parameter.
+		ignore: []string{"account_id"},
+	},
+	"spotinst_data_integration": {
+		// The status is only sent on update.
+		ignore: []string{"status"},
+	},
+	"spotinst_elastigroup_aws": {
+		values: map[string]interface{}{
+			// The ARNs are parsed to derive the target group names.
+			"target_group_arns": []interface{}{"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/test/1234567890123456"},
+		},
+		skip: []string{
+			// Cannot be combined with associate_public_ip_address.
+			"network_interface.0.network_interface_id",
+			// Waiting for capacity polls for healthy instances.
+			"wait_for_capacity",
+			"wait_for_capacity_timeout",
+			// Delays the create for the instance profile to propagate.
+			"iam_instance_profile",
+			// Only sent for tasks of the statefulUpdateCapacity type.
+			"scheduled_task.0.target_capacity",
+			"scheduled_task.0.min_capacity",
+			"scheduled_task.0.max_capacity",
+		},
+	},
+	"spotinst_elastigroup_aws_beanstalk": {
+		setup: func(api *fakeAPI) {
+			// The group is built by the API from the environment.
+			api.Respond(http.MethodGet, "/aws/ec2/group/beanstalk/import", map[string]interface{}{
+				"capacity": map[string]interface{}{},
+				"compute": map[string]interface{}{
+					"launchSpecification": map[string]interface{}{},
+					"instanceTypes":       map[string]interface{}{},
+				},
+				"strategy": map[string]interface{}{},
+			})
+		},
+		skip: []string{
+			// Toggles the maintenance mode of an existing group.
+			"maintenance",
+			// Only sent for tasks of the statefulUpdateCapacity type.
+			"scheduled_task.0.target_capacity",
+			"scheduled_task.0.min_capacity",
+			"scheduled_task.0.max_capacity",
+		},
+	},
+	"spotinst_elastigroup_azure_v3": {
+		// The SDK fails to marshal vault certificates, due to a malformed
+		// `omitempty` JSON tag.
+		skip: []string{
+			"secret",
+			// Waiting for capacity polls for running instances.
+			"wait_for_capacity",
+			"wait_for_capacity_timeout",
+		},
+	},
+	"spotinst_elastigroup_gcp": {
+		// Waiting for capacity polls for running instances.
+		skip: []string{"wait_for_capacity", "wait_for_capacity_timeout"},
+	},
+	"spotinst_elastigroup_gke": {
+		setup: func(api *fakeAPI) {
+			// The group is built by the API from the cluster.
+			api.Respond(http.MethodPost, "/gcp/gce/group/gke/import", map[string]interface{}{
+				"capacity": map[string]interface{}{},
+				"compute": map[string]interface{}{
+					"launchSpecification": map[string]interface{}{},
+					"instanceTypes":       map[string]interface{}{},
+				},
+				"scaling":                 map[string]interface{}{},
+				"strategy":                map[string]interface{}{},
+				"thirdPartiesIntegration": map[string]interface{}{},
+			})
+		},
+		// Waiting for capacity polls for running instances.
+		skip: []string{"wait_for_capacity", "wait_for_capacity_timeout"},
+	},
+	"spotinst_managed_instance_aws": {
+		// Delays the create for the instance profile to propagate.
+		skip: []string{"iam_instance_profile"},
+	},
+	"spotinst_mrscaler_aws": {
+		values: map[string]interface{}{
+			// The cluster ID is only sent for cloned or wrapped clusters.
+			"strategy": "clone",
+			// Timeouts below 15 minutes are not sent.
+			"provisioning_timeout.0.timeout": 15,
+		},
+	},
+	"spotinst_ocean_aks_np": {
+		// Waiting for nodes polls for registered nodes.
+		skip: []string{"wait_for_nodes"},
+	},
+	"spotinst_ocean_aws": {
+		// Delays the create for the instance profile to propagate, and
+		// waiting for nodes polls for running instances.
+		skip: []string{"iam_instance_profile", "wait_for_nodes"},
+	},
+	"spotinst_ocean_aws_launch_spec": {
+		values: map[string]interface{}{
+			// A string holding either "true" or "false".
+			"instance_types_filters.0.is_ena_supported": "true",
+		},
+	},
+	"spotinst_ocean_ecs": {
+		// Delays the create for the instance profile to propagate.
+		skip: []string{"iam_instance_profile"},
+	},
+	"spotinst_ocean_gke_import": {
+		// Waiting for nodes polls for registered nodes.
+		skip: []string{"wait_for_nodes"},
+	},
+	"spotinst_ocean_right_sizing_rule": {
+		setup: func(api *fakeAPI) {
+			// Addressed by name.
+			api.KeyBy("/ocean/o-12345678/rightSizing/rule", "ruleName")
+		},
+		values: map[string]interface{}{
+			"ocean_id": "o-12345678",
+		},
+	},
+	"spotinst_ocean_spark_virtual_node_group": {
+		setup: func(api *fakeAPI) {
+			// Attaching returns the dedicated node group, rather than the
+			// attach request.
+			vng := map[string]interface{}{
+				"oceanSparkClusterId": "osc-12345678",
+				"vngId":               "ols-12345678",
+			}
+			api.Respond(http.MethodPost, "/ocean/spark/cluster/osc-12345678/virtualNodeGroup", vng)
+			api.Respond(http.MethodGet, "/ocean/spark/cluster/osc-12345678/virtualNodeGroup", vng)
+		},
+		values: map[string]interface{}{
+			"ocean_spark_cluster_id": "osc-12345678",
+			"virtual_node_group_id":  "ols-12345678",
+		},
+	},
+	"spotinst_oceancd_rollout_spec": {
+		setup: func(api *fakeAPI) {
+			// Addressed by name.
+			api.KeyBy("/ocean/cd/rolloutSpec", "name")
+		},
+	},
+	"spotinst_oceancd_strategy": {
+		setup: func(api *fakeAPI) {
+			// Addressed by name.
+			api.KeyBy("/ocean/cd/strategy", "name")
+		},
+	},
+	"spotinst_oceancd_verification_provider": {
+		setup: func(api *fakeAPI) {
+			// Addressed by name.
+			api.KeyBy("/ocean/cd/verificationProvider", "name")
+		},
+	},
+	"spotinst_oceancd_verification_template": {
+		setup: func(api *fakeAPI) {
+			// Addressed by name.
+			api.KeyBy("/ocean/cd/verificationTemplate", "name")
+		},
+	},
+	"spotinst_organization_policy": {
+		setup: func(api *fakeAPI) {
+			// Created under the access API, but listed under the organization.
+			api.Alias("/setup/organization/policy", "/setup/access/policy")
+		},
+	},
+	"spotinst_organization_programmatic_user": {
+		setup: func(api *fakeAPI) {
+			// Read like any other user.
+			api.Alias("/setup/user/programmatic", "/setup/user")
+		},
+	},
+	"spotinst_organization_user": {
+		setup: func(api *fakeAPI) {
+			// The numeric id of users is not the one they are addressed by.
+			api.KeyBy("/setup/user", "userId")
+		},
+	},
+	"spotinst_organization_user_group_membership": {
+		setup: func(api *fakeAPI) {
+			api.Put("/setup/access/userGroup/ugr-12345678", map[string]interface{}{
+				"id": "ugr-12345678", "userIds": []interface{}{"u-00000001"},
+			})
+			api.Mapping("userMapping")
+		},
+		values: map[string]interface{}{
+			"user_group_id": "ugr-12345678",
+			"user_id":       "u-12345678",
+		},
+	},
+	"spotinst_organization_user_policy_attachment": {
+		setup: func(api *fakeAPI) {
+			api.Put("/setup/user/u-12345678", map[string]interface{}{"userId": "u-12345678"})
+			api.Mapping("policyMapping")
+		},
+		values: map[string]interface{}{
+			"user_id":   "u-12345678",
+			"policy_id": "pol-12345678",
+		},
+	},
+	"spotinst_organization_programmatic_user_policy_attachment": {
+		setup: func(api *fakeAPI) {
+			api.Put("/setup/user/pu-12345678", map[string]interface{}{"id": "pu-12345678"})
+			api.Mapping("policyMapping")
+		},
+		values: map[string]interface{}{
+			"programmatic_user_id": "pu-12345678",
+			"policy_id":            "pol-12345678",
+		},
+	},
+	"spotinst_organization_user_group_policy_attachment": {
+		setup: func(api *fakeAPI) {
+			api.Put("/setup/access/userGroup/ugr-12345678", map[string]interface{}{"id": "ugr-12345678"})
+			api.Mapping("policyMapping")
+		},
+		values: map[string]interface{}{
+			"user_group_id": "ugr-12345678",
+			"policy_id":     "pol-12345678",
+		},
+	},
+	"spotinst_stateful_node_azure": {
+		// Imports an existing VM instead of creating a stateful node.
+		skip: []string{"import_vm"},
+		// Base64 encoded when sent, and read back encoded.
+		ignore: []string{"custom_data"},
+	},
+}
+
+// TestResourceRoundTrip creates every resource against the fake API, using a
+// configuration generated from its schema, and reads it back. Every attribute
+// of the configuration is expected to survive the OnCreate -> API JSON ->
+// OnRead round trip.
+func TestResourceRoundTrip(t *testing.T) {
+	defer func(delay time.Duration) { mrScalerReadDelay = delay }(mrScalerReadDelay)
+	mrScalerReadDelay = 0
+
+	provider := Provider()
+
+	names := make([]string, 0, len(provider.ResourcesMap))
+	for name := range provider.ResourcesMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		name, res := name, provider.ResourcesMap[name]
+		t.Run(name, func(t *testing.T) {
+			testResourceRoundTrip(t, name, res)
+		})
+	}
+}
+
+func testResourceRoundTrip(t *testing.T, name string, res *schema.Resource) {
+	api := newFakeAPI(t)
+	client := api.Client()
+	tc := roundTripCases[name]
+	if tc.setup != nil {
+		tc.setup(api)
+	}
+
+	config := tc.generateConfig(res.Schema, "")
+	resourceData := schema.TestResourceDataRaw(t, res.Schema, config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if diags := res.CreateContext(ctx, resourceData, client); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+	if resourceData.Id() == "" {
+		t.Fatalf("create did not set an id")
+	}
+
+	read := res.Data(resourceData.State())
+	if diags := res.ReadContext(ctx, read, client); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	for key, want := range flattenRoundTripConfig(res.Schema, config, "") {
+		if tc.ignored(key) {
+			continue
+		}
+		got := read.Get(key)
+		if !reflect.DeepEqual(normalizeRoundTripValue(got), normalizeRoundTripValue(want)) {
+			t.Errorf("%s: got %#v, want %#v", key, got, want)
+		}
+	}
+}
+
+// roundTripCase customizes the configuration generated for a resource.
+type roundTripCase struct {
+	// setup configures the fake API for endpoints that do not follow the
+	// common conventions.
+	setup func(api *fakeAPI)
+
+	// values are set instead of generated values, keyed by their address,
+	// e.g. when an argument only accepts a specific format.
+	values map[string]interface{}
+
+	// skip are arguments left out of the configuration, typically because
+	// setting them triggers actions (e.g. waits or imports) that are not part
+	// of a plain create.
+	skip []string
+
+	// ignore are arguments that are not expected to be read back from the
+	// API, e.g. write-only settings or values normalized by the API.
+	ignore []string
+}
+
+func (tc roundTripCase) ignored(key string) bool {
+	for _, prefix := range tc.ignore {
+		if key == prefix || strings.HasPrefix(key, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// generateConfig returns a configuration that sets every argument of the
+// given schema, skipping arguments that conflict with ones already set.
+func (tc roundTripCase) generateConfig(s map[string]*schema.Schema, prefix string) map[string]interface{} {
+	config := make(map[string]interface{})
+
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v, address := s[k], prefix+k
+		if !v.Required && !v.Optional {
+			continue
+		}
+		if value, ok := tc.values[address]; ok {
+			config[k] = value
+			continue
+		}
+		if v.Deprecated != "" || containsString(tc.skip, address) || conflictsWithConfig(k, v, config) {
+			continue
+		}
+		config[k] = tc.generateValue(v, address)
+	}
+	return config
+}
+
+func (tc roundTripCase) generateValue(v *schema.Schema, address string) interface{} {
+	switch v.Type {
+	case schema.TypeString:
+		// Many arguments are numbers or JSON documents passed as strings, so
+		// use a numeric string, derived from the address to keep it unique.
+		h := fnv.New32a()
+		h.Write([]byte(address))
+		return strconv.Itoa(int(h.Sum32()%90000) + 10000)
+	case schema.TypeInt:
+		return 2
+	case schema.TypeFloat:
+		return 2.5
+	case schema.TypeBool:
+		return true
+	case schema.TypeMap:
+		return map[string]interface{}{"key": "value"}
+	case schema.TypeList, schema.TypeSet:
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			return []interface{}{tc.generateConfig(elem.Schema, address+".0.")}
+		case *schema.Schema:
+			return []interface{}{tc.generateValue(elem, address)}
+		}
+	}
+	panic(fmt.Sprintf("unsupported type %v of %s", v.Type, address))
+}
+
+// conflictsWithConfig reports whether the argument conflicts with, or is
+// mutually exclusive with, an argument already set in the configuration.
+func conflictsWithConfig(k string, v *schema.Schema, config map[string]interface{}) bool {
+	var keys []string
+	keys = append(keys, v.ConflictsWith...)
+	keys = append(keys, v.ExactlyOneOf...)
+
+	for _, key := range keys {
+		parts := strings.Split(key, ".")
+		key = parts[len(parts)-1]
+		if _, ok := config[key]; ok && key != k {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenRoundTripConfig returns the primitive attributes of a configuration,
+// keyed by their address, as they are expected to be stored in the state.
+func flattenRoundTripConfig(s map[string]*schema.Schema, config map[string]interface{}, prefix string) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range config {
+		sch := s[k]
+		switch sch.Type {
+		case schema.TypeList:
+			if elem, ok := sch.Elem.(*schema.Resource); ok {
+				for i, item := range v.([]interface{}) {
+					for ik, iv := range flattenRoundTripConfig(elem.Schema, item.(map[string]interface{}), fmt.Sprintf("%s%s.%d.", prefix, k, i)) {
+						out[ik] = iv
+					}
+				}
+				continue
+			}
+		case schema.TypeSet:
+			// Sets are addressed by hash, compare them as a whole.
+		default:
+			if sch.StateFunc != nil {
+				v = sch.StateFunc(v)
+			}
+		}
+		out[prefix+k] = v
+	}
+	return out
+}
+
+func normalizeRoundTripValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return normalizeRoundTripValue(v.List())
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			out = append(out, normalizeRoundTripValue(item))
+		}
+		return out
+	case map[string]interface{}:
+		// Elements of sets read back have every attribute, set or not.
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			if item := normalizeRoundTripValue(item); !isZeroRoundTripValue(item) {
+				out[k] = item
+			}
+		}
+		return out
+	}
+	return v
+}
+
+func isZeroRoundTripValue(v interface{}) bool {
+	if list, ok := v.([]interface{}); ok {
+		return len(list) == 0
+	}
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -27,7 +27,8 @@ func resourceSpotinstAccount() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.AccountResource.GetSchemaMap(),
+		Schema:   commons.AccountResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -25,7 +25,8 @@ func resourceSpotinstAccountAWS() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.AccountAWSResource.GetSchemaMap(),
+		Schema:   commons.AccountAWSResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -26,7 +26,8 @@ func resourceSpotinstCredentialsAWS() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.CredentialsAWSResource.GetSchemaMap(),
+		Schema:   commons.CredentialsAWSResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
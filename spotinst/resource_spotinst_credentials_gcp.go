@@ -26,7 +26,8 @@ func resourceSpotinstCredentialsGCP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.CredentialsGCPResource.GetSchemaMap(),
+		Schema:   commons.CredentialsGCPResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,7 +28,8 @@ func resourceSpotinstDataIntegration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.DataIntegrationResource.GetSchemaMap(),
+		Schema:   commons.DataIntegrationResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -84,7 +84,7 @@ func resourceSpotinstDataIntegrationCreate(ctx context.Context, resourceData *sc
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	DataIntegrationId, err := createDataIntegration(resourceData, DataIntegration, meta.(*Client))
+	DataIntegrationId, err := createDataIntegration(ctx, resourceData, DataIntegration, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -97,16 +97,16 @@ func resourceSpotinstDataIntegrationCreate(ctx context.Context, resourceData *sc
 
 }
 
-func createDataIntegration(resourceData *schema.ResourceData, di *aws.DataIntegration, spotinstClient *Client) (*string, error) {
+func createDataIntegration(ctx context.Context, resourceData *schema.ResourceData, di *aws.DataIntegration, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(di); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> DataIntegration create configuration: %s", json)
 	}
 	var resp *aws.CreateDataIntegrationOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateDataIntegrationInput{DataIntegration: di}
-		r, err := spotinstClient.dataIntegration.CloudProviderAWS().CreateDataIntegration(context.Background(), input)
+		r, err := spotinstClient.dataIntegration.CloudProviderAWS().CreateDataIntegration(ctx, input)
 		if err != nil {
 			// Some other error, report it.
 			return resource.NonRetryableError(err)
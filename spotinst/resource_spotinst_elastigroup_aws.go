@@ -43,7 +43,12 @@ func resourceSpotinstElastigroupAWS() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.ElastigroupResource.GetSchemaMap(),
+		Schema:        commons.ElastigroupResource.GetSchemaMap(),
+		CustomizeDiff: commons.ElastigroupResource.CustomizeDiff,
+
+		SchemaVersion:  commons.ElastigroupResource.SchemaVersion(),
+		StateUpgraders: commons.ElastigroupResource.StateUpgraders(),
+		Timeouts:       commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -174,7 +179,7 @@ func resourceSpotinstElastigroupAWSCreate(ctx context.Context, resourceData *sch
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createGroup(resourceData, elastigroup, meta.(*Client))
+	groupId, err := createGroup(ctx, resourceData, elastigroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -187,7 +192,7 @@ func resourceSpotinstElastigroupAWSCreate(ctx context.Context, resourceData *sch
 			return diag.Errorf("[ERROR] Your target healthy capacity must be less than or equal to your desired capcity")
 		}
 		if timeout, ok := resourceData.GetOkExists(string(elastigroup_aws.WaitForCapacityTimeout)); ok {
-			err := awaitReady(groupId, timeout.(int), capacity.(int), meta.(*Client))
+			err := awaitReady(ctx, groupId, timeout.(int), capacity.(int), meta.(*Client))
 			if err != nil {
 				return diag.Errorf("[ERROR] Timed out when creating group: %s", err)
 			}
@@ -199,7 +204,7 @@ func resourceSpotinstElastigroupAWSCreate(ctx context.Context, resourceData *sch
 	return resourceSpotinstElastigroupAWSRead(ctx, resourceData, meta)
 }
 
-func createGroup(resourceData *schema.ResourceData, group *aws.Group, spotinstClient *Client) (*string, error) {
+func createGroup(ctx context.Context, resourceData *schema.ResourceData, group *aws.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(group); err != nil {
 		return nil, err
 	} else {
@@ -212,9 +217,9 @@ func createGroup(resourceData *schema.ResourceData, group *aws.Group, spotinstCl
 	}
 
 	var resp *aws.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateGroupInput{Group: group}
-		r, err := spotinstClient.elastigroup.CloudProviderAWS().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderAWS().Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the group creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -259,7 +264,7 @@ func resourceSpotinstElastigroupAWSUpdate(ctx context.Context, resourceData *sch
 
 	if shouldUpdate {
 		elastigroup.SetId(spotinst.String(id))
-		if err := updateGroup(elastigroup, resourceData, meta); err != nil {
+		if err := updateGroup(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -268,7 +273,7 @@ func resourceSpotinstElastigroupAWSUpdate(ctx context.Context, resourceData *sch
 	return resourceSpotinstElastigroupAWSRead(ctx, resourceData, meta)
 }
 
-func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGroup(ctx context.Context, elastigroup *aws.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &aws.UpdateGroupInput{
 		Group: elastigroup,
 	}
@@ -303,7 +308,6 @@ func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta
 			return err
 		}
 
-		ctx := context.TODO()
 		svc := meta.(*Client).elastigroup.CloudProviderAWS()
 
 		for _, action := range actionList {
@@ -338,10 +342,10 @@ func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
 	} else if shouldRoll {
-		if err := rollGroup(resourceData, meta); err != nil {
+		if err := rollGroup(ctx, resourceData, meta); err != nil {
 			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
 			return err
 		}
@@ -354,7 +358,7 @@ func updateGroup(elastigroup *aws.Group, resourceData *schema.ResourceData, meta
 				}
 
 				if timeout, ok := resourceData.GetOkExists(string(elastigroup_aws.WaitForCapacityTimeout)); ok {
-					err := awaitReady(spotinst.String(groupId), timeout.(int), capacity.(int), meta.(*Client))
+					err := awaitReady(ctx, spotinst.String(groupId), timeout.(int), capacity.(int), meta.(*Client))
 					if err != nil {
 						return fmt.Errorf("[ERROR] Timed out when updating group: %s", err)
 					}
@@ -442,8 +446,7 @@ func deallocateStatefulInstance(ctx context.Context, svc aws.Service, groupID, i
 	return nil
 }
 
-func rollGroup(resourceData *schema.ResourceData, meta interface{}) error {
-	ctx := context.Background()
+func rollGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupID := resourceData.Id()
 
 	updatePolicy, exists := resourceData.GetOkExists(string(elastigroup_aws.UpdatePolicy))
@@ -473,9 +476,9 @@ func rollGroup(resourceData *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("onRoll() -> Rolling group [%v] with configuration %s", groupID, json)
 
-	retryTimeout := spotinst.IntValue(getRollTimeout(rollConfig))
+	retryTimeout := time.Duration(spotinst.IntValue(getRollTimeout(rollConfig))) * time.Second
 	if retryTimeout == 0 {
-		retryTimeout = 300
+		retryTimeout = resourceData.Timeout(schema.TimeoutUpdate)
 	}
 
 	var rollECS bool
@@ -525,7 +528,7 @@ func rollGroup(resourceData *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	return resource.RetryContext(context.Background(), time.Duration(retryTimeout)*time.Second, retryFn)
+	return resource.RetryContext(ctx, retryTimeout, retryFn)
 }
 
 func convertToECSRollInput(rollGroupInput *aws.RollGroupInput) *aws.RollECSGroupInput {
@@ -536,15 +539,15 @@ func convertToECSRollInput(rollGroupInput *aws.RollGroupInput) *aws.RollECSGroup
 	return r
 }
 
-func awaitReady(groupId *string, timeout int, capacity int, client *Client) error {
+func awaitReady(ctx context.Context, groupId *string, timeout int, capacity int, client *Client) error {
 	if capacity == 0 || timeout == 0 {
 		return nil
 	}
 
-	err := resource.RetryContext(context.Background(), time.Second*time.Duration(timeout), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Second*time.Duration(timeout), func() *resource.RetryError {
 		input := &aws.GetInstanceHealthinessInput{GroupID: spotinst.String(*groupId)}
 		numHealthy := 0
-		status, err := client.elastigroup.CloudProviderAWS().GetInstanceHealthiness(context.Background(), input)
+		status, err := client.elastigroup.CloudProviderAWS().GetInstanceHealthiness(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("[ERROR] awaitReady() -> getInstanceHealthiness [%v] API call failed, error: %v", groupId, err))
 		}
@@ -587,7 +590,7 @@ func awaitReadyRoll(ctx context.Context, groupID string, rollConfig interface{},
 	}
 
 	svc := client.elastigroup.CloudProviderAWS()
-	err := resource.RetryContext(context.Background(), time.Second*time.Duration(pctTimeout), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Second*time.Duration(pctTimeout), func() *resource.RetryError {
 		var rollStatus *aws.RollGroupOutput
 		var rollErr error
 
@@ -0,0 +1,160 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_instance_operation"
+)
+
+// resourceSpotinstElastigroupAWSInstanceOperation performs a one-off detach
+// or scale operation on an Elastigroup when created. There is nothing to read
+// back from the API, and destroying the resource does not revert it.
+func resourceSpotinstElastigroupAWSInstanceOperation() *schema.Resource {
+	setupElastigroupAWSInstanceOperationResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstElastigroupAWSInstanceOperationCreate,
+		ReadContext:   schema.NoopContext,
+		DeleteContext: schema.NoopContext,
+
+		Schema:        commons.ElastigroupAWSInstanceOperationResource.GetSchemaMap(),
+		CustomizeDiff: resourceSpotinstElastigroupAWSInstanceOperationCustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
+	}
+}
+
+func setupElastigroupAWSInstanceOperationResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_instance_operation.Setup(fieldsMap)
+
+	commons.ElastigroupAWSInstanceOperationResource = commons.NewElastigroupAWSInstanceOperationResource(fieldsMap)
+}
+
+// resourceSpotinstElastigroupAWSInstanceOperationCustomizeDiff shows the
+// instances to detach as the affected instances in the plan. The instances
+// affected by a scale are only known once it is performed.
+func resourceSpotinstElastigroupAWSInstanceOperationCustomizeDiff(ctx context.Context, resourceDiff *schema.ResourceDiff, meta interface{}) error {
+	if err := commons.ElastigroupAWSInstanceOperationResource.CustomizeDiff(ctx, resourceDiff, meta); err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s.0.%s", elastigroup_aws_instance_operation.Detach, elastigroup_aws_instance_operation.InstanceIDs)
+	if resourceDiff.Id() != "" || !resourceDiff.NewValueKnown(key) {
+		return nil
+	}
+
+	if v, ok := resourceDiff.GetOk(key); ok {
+		var instanceIDs []string
+		for _, id := range v.(*schema.Set).List() {
+			instanceIDs = append(instanceIDs, id.(string))
+		}
+		sort.Strings(instanceIDs)
+		return resourceDiff.SetNew(string(elastigroup_aws_instance_operation.AffectedInstanceIDs), instanceIDs)
+	}
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSInstanceOperationCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.ElastigroupAWSInstanceOperationResource.GetName())
+
+	operation, err := commons.ElastigroupAWSInstanceOperationResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var instanceIDs []string
+	switch {
+	case operation.Detach != nil:
+		instanceIDs, err = detachElastigroupAWSInstances(ctx, operation, meta.(*Client))
+	case operation.Scale != nil:
+		instanceIDs, err = scaleElastigroupAWS(ctx, operation, meta.(*Client))
+	default:
+		err = fmt.Errorf("[ERROR] one of %q or %q must be set",
+			elastigroup_aws_instance_operation.Detach, elastigroup_aws_instance_operation.Scale)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Strings(instanceIDs)
+	if err := resourceData.Set(string(elastigroup_aws_instance_operation.AffectedInstanceIDs), instanceIDs); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(elastigroup_aws_instance_operation.AffectedInstanceIDs), err)
+	}
+
+	resourceData.SetId(resource.PrefixedUniqueId(spotinst.StringValue(operation.GroupID) + "-"))
+	log.Printf("===> Elastigroup instance operation performed successfully: %s <===", resourceData.Id())
+	return nil
+}
+
+func detachElastigroupAWSInstances(ctx context.Context, operation *commons.ElastigroupAWSInstanceOperationWrapper, spotinstClient *Client) ([]string, error) {
+	input := operation.Detach
+	input.GroupID = operation.GroupID
+	instanceIDs := append([]string(nil), input.InstanceIDs...)
+
+	if json, err := commons.ToJson(input); err != nil {
+		return nil, err
+	} else {
+		log.Printf("===> Elastigroup detach configuration: %s", json)
+	}
+
+	if _, err := spotinstClient.elastigroup.CloudProviderAWS().Detach(ctx, input); err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to detach instances %v from group [%v]: %v",
+			instanceIDs, spotinst.StringValue(operation.GroupID), err)
+	}
+	return instanceIDs, nil
+}
+
+func scaleElastigroupAWS(ctx context.Context, operation *commons.ElastigroupAWSInstanceOperationWrapper, spotinstClient *Client) ([]string, error) {
+	input := operation.Scale
+	input.GroupID = operation.GroupID
+	scaleType := spotinst.StringValue(input.ScaleType)
+
+	if json, err := commons.ToJson(input); err != nil {
+		return nil, err
+	} else {
+		log.Printf("===> Elastigroup scale configuration: %s", json)
+	}
+
+	output, err := spotinstClient.elastigroup.CloudProviderAWS().Scale(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to scale %s group [%v]: %v",
+			scaleType, spotinst.StringValue(operation.GroupID), err)
+	}
+	return flattenScaleInstanceIDs(output), nil
+}
+
+// flattenScaleInstanceIDs returns the IDs of the instances and spot requests
+// launched by a scale up, or terminated by a scale down.
+func flattenScaleInstanceIDs(output *aws.ScaleGroupOutput) []string {
+	var ids []string
+	if output == nil {
+		return ids
+	}
+
+	for _, item := range output.Items {
+		for _, i := range item.NewInstances {
+			ids = append(ids, spotinst.StringValue(i.InstanceID))
+		}
+		for _, r := range item.NewSpotRequests {
+			ids = append(ids, spotinst.StringValue(r.SpotInstanceRequestID))
+		}
+		for _, i := range item.VictimInstances {
+			ids = append(ids, spotinst.StringValue(i.InstanceID))
+		}
+		for _, r := range item.VictimSpotRequests {
+			ids = append(ids, spotinst.StringValue(r.SpotInstanceRequestID))
+		}
+	}
+	return ids
+}
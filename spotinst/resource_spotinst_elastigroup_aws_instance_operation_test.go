@@ -0,0 +1,110 @@
+package spotinst
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestElastigroupAWSInstanceOperation_DetachPlan(t *testing.T) {
+	r := resourceSpotinstElastigroupAWSInstanceOperation()
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"group_id": "sig-12345678",
+		"detach": []interface{}{
+			map[string]interface{}{
+				"instance_ids":                     []interface{}{"i-2", "i-1"},
+				"should_decrement_target_capacity": true,
+			},
+		},
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for key, want := range map[string]string{
+		"affected_instance_ids.#": "2",
+		"affected_instance_ids.0": "i-1",
+		"affected_instance_ids.1": "i-2",
+	} {
+		if attr, ok := diff.Attributes[key]; !ok || attr.New != want {
+			t.Errorf("%s: got %+v, want %q", key, attr, want)
+		}
+	}
+}
+
+func TestElastigroupAWSInstanceOperation_Detach(t *testing.T) {
+	api := newFakeAPI(t)
+	r := resourceSpotinstElastigroupAWSInstanceOperation()
+
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"group_id": "sig-12345678",
+		"detach": []interface{}{
+			map[string]interface{}{
+				"instance_ids":                     []interface{}{"i-1"},
+				"should_decrement_target_capacity": false,
+				"should_terminate_instances":       true,
+				"draining_timeout":                 120,
+			},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+
+	requests := api.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("requests: got %d, want 1", len(requests))
+	}
+	detach := requests[0]
+	if detach.Method != http.MethodPut || detach.Path != "/aws/ec2/group/sig-12345678/detachInstances" {
+		t.Fatalf("detach request: got %s %s", detach.Method, detach.Path)
+	}
+
+	want := map[string]interface{}{
+		"instancesToDetach":             []interface{}{"i-1"},
+		"shouldDecrementTargetCapacity": false,
+		"shouldTerminateInstances":      true,
+		"drainingTimeout":               120.0,
+	}
+	if !reflect.DeepEqual(detach.Body, want) {
+		t.Errorf("detach body: got %v, want %v", detach.Body, want)
+	}
+	if got := resourceData.Get("affected_instance_ids"); !reflect.DeepEqual(got, []interface{}{"i-1"}) {
+		t.Errorf("affected_instance_ids: got %v", got)
+	}
+}
+
+func TestElastigroupAWSInstanceOperation_ScaleDown(t *testing.T) {
+	api := newFakeAPI(t)
+	api.Respond(http.MethodPut, "/aws/ec2/group/sig-12345678/scale/down", map[string]interface{}{
+		"victimInstances":    []interface{}{map[string]interface{}{"instanceId": "i-2"}},
+		"victimSpotRequests": []interface{}{map[string]interface{}{"spotInstanceRequestId": "sir-1"}},
+	})
+	r := resourceSpotinstElastigroupAWSInstanceOperation()
+
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"group_id": "sig-12345678",
+		"scale": []interface{}{
+			map[string]interface{}{
+				"type":       "down",
+				"adjustment": 2,
+			},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+
+	scale := api.Requests()[0]
+	if got := scale.Query.Get("adjustment"); got != "2" {
+		t.Errorf("adjustment: got %q, want 2", got)
+	}
+	if got, want := resourceData.Get("affected_instance_ids"), []interface{}{"i-2", "sir-1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("affected_instance_ids: got %v, want %v", got, want)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -30,7 +29,8 @@ func resourceSpotinstElastigroupSuspendProcesses() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.SuspendProcessesResource.GetSchemaMap(),
+		Schema:   commons.SuspendProcessesResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -95,7 +95,7 @@ func resourceSpotinstAWSSuspendProcessesCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	suspendProcessesId, err := createSuspendProcesses(resourceData, suspendProcesses, meta.(*Client))
+	suspendProcessesId, err := createSuspendProcesses(ctx, resourceData, suspendProcesses, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -108,19 +108,19 @@ func resourceSpotinstAWSSuspendProcessesCreate(ctx context.Context, resourceData
 
 }
 
-func createSuspendProcesses(resourceData *schema.ResourceData, suspendProcesses *aws.SuspendProcesses, spotinstClient *Client) (*string, error) {
+func createSuspendProcesses(ctx context.Context, resourceData *schema.ResourceData, suspendProcesses *aws.SuspendProcesses, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(suspendProcesses); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> SuspendProcesses create configuration: %s", json)
 	}
 	groupID := spotinst.String(resourceData.Get(string(elastigroup_aws_suspend_processes.GroupID)).(string))
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateSuspensionsInput{
 			GroupID:     groupID,
 			Suspensions: suspendProcesses.Suspensions,
 		}
-		_, err := spotinstClient.elastigroup.CloudProviderAWS().CreateSuspensions(context.Background(), input)
+		_, err := spotinstClient.elastigroup.CloudProviderAWS().CreateSuspensions(ctx, input)
 		if err != nil {
 			// an error occurred, no retryable errors for this resource.
 			return resource.NonRetryableError(err)
@@ -4707,3 +4707,101 @@ const testElastigroupLoggingAWSConfig_EmptyFields = `
 `
 
 // endregion
+
+func TestElastigroupAWS_ValidateDiff(t *testing.T) {
+	api := newFakeAPI(t)
+	r := resourceSpotinstElastigroupAWS()
+
+	rollConfig := func(m map[string]interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"should_resume_stateful": false,
+			"should_roll":            true,
+			"roll_config":            []interface{}{m},
+		}}
+	}
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		want   string
+	}{
+		{name: "valid capacity",
+			config: map[string]interface{}{"min_size": 0, "desired_capacity": 1, "max_size": 2}},
+		{name: "min greater than max",
+			config: map[string]interface{}{"min_size": 3, "desired_capacity": 3, "max_size": 2},
+			want:   `"min_size" (3) must be less than or equal to "max_size" (2)`},
+		{name: "desired greater than zero max",
+			config: map[string]interface{}{"min_size": 0, "desired_capacity": 1, "max_size": 0},
+			want:   `"desired_capacity" (1) must be less than or equal to "max_size" (0)`},
+		{name: "valid wait for capacity",
+			config: map[string]interface{}{"desired_capacity": 2, "wait_for_capacity": 2, "wait_for_capacity_timeout": 300}},
+		{name: "wait for capacity greater than desired",
+			config: map[string]interface{}{"desired_capacity": 1, "wait_for_capacity": 2, "wait_for_capacity_timeout": 300},
+			want:   `"wait_for_capacity" (2) must be less than or equal to "desired_capacity" (1)`},
+		{name: "wait for capacity without timeout",
+			config: map[string]interface{}{"desired_capacity": 2, "wait_for_capacity": 2},
+			want:   `"wait_for_capacity_timeout" must be set together with "wait_for_capacity"`},
+		{name: "valid roll config",
+			config: map[string]interface{}{"update_policy": rollConfig(map[string]interface{}{
+				"batch_size_percentage": 33, "wait_for_roll_percentage": 100, "wait_for_roll_timeout": 900,
+			})}},
+		{name: "batch size percentage out of range",
+			config: map[string]interface{}{"update_policy": rollConfig(map[string]interface{}{
+				"batch_size_percentage": 150,
+			})},
+			want: "must be between 0 and 100, got: 150"},
+		{name: "wait for roll percentage without timeout",
+			config: map[string]interface{}{"update_policy": rollConfig(map[string]interface{}{
+				"batch_size_percentage": 33, "wait_for_roll_percentage": 100,
+			})},
+			want: "wait_for_roll_timeout\" must be set together with"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{"name": "group"}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), api.Client())
+			if tc.want == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.want != "" && (err == nil || !strings.Contains(err.Error(), tc.want)) {
+				t.Errorf("got %v, want an error containing %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestElastigroupAWS_StateUpgradeV0(t *testing.T) {
+	r := resourceSpotinstElastigroupAWS()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("got schema version %d with %d upgraders, want 1 with 1", r.SchemaVersion, len(r.StateUpgraders))
+	}
+
+	rawState := map[string]interface{}{
+		"id":              "sig-12345678",
+		"name":            "group",
+		"user_data":       "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+		"shutdown_script": "ZWNobyBieWU=",
+	}
+
+	got, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"id":              "sig-12345678",
+		"name":            "group",
+		"user_data":       "",
+		"shutdown_script": "ZWNobyBieWU=",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s: got %#v, want %#v", k, got[k], v)
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure_extension"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure_health"
@@ -12,6 +11,8 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure_scaling_policies"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure_scheduling"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/azure_v3/elastigroup_azure_secrets"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_stateful"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_update_policy"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -43,7 +44,9 @@ func resourceSpotinstElastigroupAzureV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.ElastigroupAzureV3Resource.GetSchemaMap(),
+		Schema:        commons.ElastigroupAzureV3Resource.GetSchemaMap(),
+		CustomizeDiff: commons.ElastigroupAzureV3Resource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -63,6 +66,8 @@ func setupElastigroupAzureV3Resource() {
 	elastigroup_azure_load_balancer.Setup(fieldsMap)
 	elastigroup_azure_health.Setup(fieldsMap)
 	elastigroup_azure_scheduling.Setup(fieldsMap)
+	elastigroup_stateful.Setup(fieldsMap)
+	elastigroup_update_policy.Setup(fieldsMap)
 
 	commons.ElastigroupAzureV3Resource = commons.NewElastigroupAzureV3Resource(fieldsMap)
 }
@@ -76,19 +81,31 @@ func resourceSpotinstElastigroupAzureV3Create(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createAzureV3Group(elastigroup, meta.(*Client))
+	groupId, err := createAzureV3Group(ctx, resourceData, elastigroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(groupId))
 
+	c, err := azureV3GroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := azureV3GroupStatefulEndpoint.onCreate(ctx, resourceData, c); err != nil {
+		return diag.Errorf("[ERROR] Failed to set persistence of group [%v]: %v", resourceData.Id(), err)
+	}
+
+	if err := waitForAzureV3GroupCapacity(ctx, resourceData, meta); err != nil {
+		return diag.Errorf("[ERROR] Timed out when creating group: %s", err)
+	}
+
 	log.Printf("===> Elastigroup created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstElastigroupAzureV3Read(ctx, resourceData, meta)
 }
 
-func createAzureV3Group(group *v3.Group, spotinstClient *Client) (*string, error) {
+func createAzureV3Group(ctx context.Context, resourceData *schema.ResourceData, group *v3.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(group); err != nil {
 		return nil, err
 	} else {
@@ -96,9 +113,9 @@ func createAzureV3Group(group *v3.Group, spotinstClient *Client) (*string, error
 	}
 
 	var resp *v3.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &v3.CreateGroupInput{Group: group}
-		r, err := spotinstClient.elastigroup.CloudProviderAzureV3().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderAzureV3().Create(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -147,6 +164,14 @@ func resourceSpotinstElastigroupAzureV3Read(ctx context.Context, resourceData *s
 	if err := commons.ElastigroupAzureV3Resource.OnRead(groupResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	c, err := azureV3GroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := azureV3GroupStatefulEndpoint.onRead(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf("===> Elastigroup read successfully: %s <===", id)
 	return nil
 }
@@ -163,16 +188,24 @@ func resourceSpotinstElastigroupAzureV3Update(ctx context.Context, resourceData
 
 	if shouldUpdate {
 		elastigroup.SetId(spotinst.String(id))
-		if err := updateAzureV3Group(elastigroup, resourceData, meta); err != nil {
+		if err := updateAzureV3Group(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	c, err := azureV3GroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := azureV3GroupStatefulEndpoint.onUpdate(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> Elastigroup updated successfully: %s <===", id)
 	return resourceSpotinstElastigroupAzureV3Read(ctx, resourceData, meta)
 }
 
-func updateAzureV3Group(elastigroup *v3.Group, resourceData *schema.ResourceData, meta interface{}) error {
+func updateAzureV3Group(ctx context.Context, elastigroup *v3.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &v3.UpdateGroupInput{
 		Group: elastigroup,
 	}
@@ -185,8 +218,18 @@ func updateAzureV3Group(elastigroup *v3.Group, resourceData *schema.ResourceData
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderAzureV3().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
+	} else if shouldRollElastigroup(resourceData) {
+		if err := rollAzureV3Group(ctx, resourceData, meta); err != nil {
+			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping group roll", string(elastigroup_update_policy.ShouldRoll))
+		if err := waitForAzureV3GroupCapacity(ctx, resourceData, meta); err != nil {
+			return fmt.Errorf("[ERROR] Timed out when updating group: %s", err)
+		}
 	}
 	return nil
 }
@@ -196,7 +239,7 @@ func resourceSpotinstElastigroupAzureV3Delete(ctx context.Context, resourceData
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ElastigroupAzureV3Resource.GetName(), id)
 
-	if err := deleteAzureV3Group(resourceData, meta); err != nil {
+	if err := deleteAzureV3Group(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -205,12 +248,23 @@ func resourceSpotinstElastigroupAzureV3Delete(ctx context.Context, resourceData
 	return nil
 }
 
-func deleteAzureV3Group(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteAzureV3Group(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupId := resourceData.Id()
 	input := &v3.DeleteGroupInput{
 		GroupID: spotinst.String(groupId),
 	}
 
+	if expandElastigroupStatefulDeallocation(resourceData) != nil {
+		c, err := azureV3GroupClient(meta)
+		if err != nil {
+			return err
+		}
+		if err := azureV3GroupStatefulEndpoint.onDelete(ctx, resourceData, c); err != nil {
+			return fmt.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
+		}
+		return nil
+	}
+
 	if json, err := commons.ToJson(input); err != nil {
 		return err
 	} else {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -24,6 +23,8 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gcp_scaling_policies"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gcp_scheduled_task"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gcp_strategy"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_stateful"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_update_policy"
 )
 
 func resourceSpotinstElastigroupGCP() *schema.Resource {
@@ -39,7 +40,9 @@ func resourceSpotinstElastigroupGCP() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.ElastigroupGCPResource.GetSchemaMap(),
+		Schema:        commons.ElastigroupGCPResource.GetSchemaMap(),
+		CustomizeDiff: commons.ElastigroupGCPResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -57,6 +60,8 @@ func setupElastigroupGCPResource() {
 	elastigroup_gcp_scaling_policies.Setup(fieldsMap)
 	elastigroup_gcp_scheduled_task.Setup(fieldsMap)
 	elastigroup_gcp_strategy.Setup(fieldsMap)
+	elastigroup_stateful.Setup(fieldsMap)
+	elastigroup_update_policy.Setup(fieldsMap)
 
 	commons.ElastigroupGCPResource = commons.NewElastigroupGCPResource(fieldsMap)
 }
@@ -72,12 +77,29 @@ func resourceSpotinstElastigroupGCPCreate(ctx context.Context, resourceData *sch
 		return diag.FromErr(err)
 	}
 
-	groupId, err := createGCPGroup(elastigroup, meta.(*Client))
+	groupId, err := createGCPGroup(ctx, resourceData, elastigroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(groupId))
+
+	c, err := gcpGroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := gcpGroupStatefulEndpoint.onCreate(ctx, resourceData, c); err != nil {
+		return diag.Errorf("[ERROR] Failed to set persistence of group [%v]: %v", resourceData.Id(), err)
+	}
+	if err := onCreateGCPGroupScaling(ctx, resourceData, c); err != nil {
+		return diag.Errorf("[ERROR] Failed to set scaling of group [%v]: %v", resourceData.Id(), err)
+	}
+
+	if err := waitForGCPGroupCapacity(ctx, resourceData, meta,
+		elastigroup_gcp.WaitForCapacity, elastigroup_gcp.WaitForCapacityTimeout); err != nil {
+		return diag.Errorf("[ERROR] Timed out when creating group: %s", err)
+	}
+
 	log.Printf("===> Elastigroup created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstElastigroupGCPRead(ctx, resourceData, meta)
 }
@@ -85,16 +107,16 @@ func resourceSpotinstElastigroupGCPCreate(ctx context.Context, resourceData *sch
 // createGCPGroup makes the create request to the spotinst API and returns
 // the group ID of created group or an error if the request fails. It will retry
 // the request (default 1 min) when encountering a retryable error.
-func createGCPGroup(elastigroup *gcp.Group, spotinstClient *Client) (*string, error) {
+func createGCPGroup(ctx context.Context, resourceData *schema.ResourceData, elastigroup *gcp.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(elastigroup); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> Group create configuration: %s", json)
 	}
 	var resp *gcp.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateGroupInput{Group: elastigroup}
-		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -142,6 +164,17 @@ func resourceSpotinstElastigroupGCPRead(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
+	c, err := gcpGroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := gcpGroupStatefulEndpoint.onRead(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := onReadGCPGroupScaling(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> Elastigroup read successfully: %s <===", groupId)
 	if json, err := commons.ToJson(groupResponse); err != nil {
 		return diag.FromErr(err)
@@ -165,17 +198,29 @@ func resourceSpotinstElastigroupGCPUpdate(ctx context.Context, resourceData *sch
 
 	if shouldUpdate {
 		elastigroup.SetID(spotinst.String(groupId))
-		if err := updateGCPGroup(elastigroup, resourceData, meta); err != nil {
+		if err := updateGCPGroup(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	c, err := gcpGroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := gcpGroupStatefulEndpoint.onUpdate(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := onUpdateGCPGroupScaling(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> Elastigroup updated successfully: %s <===", groupId)
 	return resourceSpotinstElastigroupGCPRead(ctx, resourceData, meta)
 }
 
-// updateGCPGroup sends the update request to the Spotinst API and returns an error if the request fails.
-func updateGCPGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
+// updateGCPGroup sends the update request to the Spotinst API, rolls the group when
+// the update policy asks to, and returns an error if any of the requests fails.
+func updateGCPGroup(ctx context.Context, elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &gcp.UpdateGroupInput{Group: elastigroup}
 	groupId := resourceData.Id()
 
@@ -185,10 +230,23 @@ func updateGCPGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, m
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
 	}
 
+	if shouldRollElastigroup(resourceData) {
+		if err := rollGCPGroup(ctx, resourceData, meta); err != nil {
+			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping group roll", string(elastigroup_update_policy.ShouldRoll))
+		if err := waitForGCPGroupCapacity(ctx, resourceData, meta,
+			elastigroup_gcp.WaitForCapacity, elastigroup_gcp.WaitForCapacityTimeout); err != nil {
+			return fmt.Errorf("[ERROR] Timed out when updating group: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -198,7 +256,7 @@ func resourceSpotinstElastigroupGCPDelete(ctx context.Context, resourceData *sch
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.ElastigroupGCPResource.GetName(), groupId)
 
-	if err := deleteGCPGroup(resourceData, meta); err != nil {
+	if err := deleteGCPGroup(ctx, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -208,10 +266,21 @@ func resourceSpotinstElastigroupGCPDelete(ctx context.Context, resourceData *sch
 }
 
 // deleteGCPGroup sends the delete request to the Spotinst API or an error if the request fails.
-func deleteGCPGroup(resourceData *schema.ResourceData, meta interface{}) error {
+func deleteGCPGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
 	groupId := resourceData.Id()
 	input := &gcp.DeleteGroupInput{GroupID: spotinst.String(groupId)}
 
+	if expandElastigroupStatefulDeallocation(resourceData) != nil {
+		c, err := gcpGroupClient(meta)
+		if err != nil {
+			return err
+		}
+		if err := gcpGroupStatefulEndpoint.onDelete(ctx, resourceData, c); err != nil {
+			return fmt.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
+		}
+		return nil
+	}
+
 	if json, err := commons.ToJson(input); err != nil {
 		return err
 	} else {
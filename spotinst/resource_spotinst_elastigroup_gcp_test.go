@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
@@ -1607,3 +1608,67 @@ const testGCPScheduledTaskGroupConfig_EmptyFields = `
 `
 
 // endregion
+
+func TestElastigroupGCPScalingPolicies_Validation(t *testing.T) {
+	r := resourceSpotinstElastigroupGCP()
+	var state *terraform.InstanceState
+
+	policy := func(name string, dimensions ...string) map[string]interface{} {
+		dims := make([]interface{}, 0, len(dimensions))
+		for _, d := range dimensions {
+			dims = append(dims, map[string]interface{}{"name": d, "value": "value-1"})
+		}
+		return map[string]interface{}{
+			"policy_name": name,
+			"metric_name": "CPUUtilization",
+			"namespace":   "test-namespace",
+			"unit":        "percent",
+			"threshold":   80,
+			"dimensions":  dims,
+		}
+	}
+	diff := func(up, down []interface{}) error {
+		_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                "group",
+			"scaling_up_policy":   up,
+			"scaling_down_policy": down,
+		}), nil)
+		return err
+	}
+
+	cases := []struct {
+		name     string
+		up, down []interface{}
+		want     string
+	}{
+		{"distinct dimensions", []interface{}{policy("up-1", "name-1"), policy("up-2", "name-2")}, nil, ""},
+		{"same metric across directions", []interface{}{policy("up-1")}, []interface{}{policy("down-1")}, ""},
+		{"stepped scaling", []interface{}{policy("up-1", "name-1"), policy("up-2", "name-1")}, nil, ""},
+		{"shared policy name", []interface{}{policy("policy", "name-1")}, []interface{}{policy("policy", "name-2")}, ""},
+		{"repeated dimension", []interface{}{policy("up-1", "name-1", "name-1")}, nil, `dimension "name-1" is set more than once`},
+	}
+	for _, c := range cases {
+		err := diff(c.up, c.down)
+		if c.want == "" && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if c.want != "" && (err == nil || !strings.Contains(err.Error(), c.want)) {
+			t.Errorf("%s: got %v, want an error containing %q", c.name, err, c.want)
+		}
+	}
+
+	// Policies added to an existing group are compared to the existing ones.
+	existing := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":              "group",
+		"scaling_up_policy": []interface{}{policy("up-1", "name-1")},
+	})
+	existing.SetId("sig-12345678")
+	state = existing.State()
+
+	if err := diff([]interface{}{policy("up-1", "name-1"), policy("up-2", "name-1")}, nil); err != nil {
+		t.Errorf("added policy: unexpected error: %v", err)
+	}
+	if err := diff([]interface{}{policy("up-1", "name-1"), policy("up-2", "name-2", "name-2")}, nil); err == nil {
+		t.Errorf("added policy with a repeated dimension: got nil, want an error")
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -23,6 +22,7 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gcp_scaling_policies"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gcp_strategy"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gke"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_update_policy"
 )
 
 func resourceSpotinstElastigroupGKE() *schema.Resource {
@@ -38,7 +38,9 @@ func resourceSpotinstElastigroupGKE() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.ElastigroupGKEResource.GetSchemaMap(),
+		Schema:        commons.ElastigroupGKEResource.GetSchemaMap(),
+		CustomizeDiff: commons.ElastigroupGKEResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -55,6 +57,7 @@ func setupElastigroupGKEResource() {
 	elastigroup_gcp_network_interface.Setup(fieldsMap)
 	elastigroup_gcp_scaling_policies.Setup(fieldsMap)
 	elastigroup_gcp_strategy.Setup(fieldsMap)
+	elastigroup_update_policy.Setup(fieldsMap)
 
 	commons.ElastigroupGKEResource = commons.NewElastigroupGKEResource(fieldsMap)
 }
@@ -148,17 +151,31 @@ func resourceSpotinstElastigroupGKECreate(ctx context.Context, resourceData *sch
 	}
 
 	// call create with the reconciled group
-	groupId, err := createGKEGroup(tempGroup, meta.(*Client))
+	groupId, err := createGKEGroup(ctx, resourceData, tempGroup, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(groupId))
+
+	c, err := gcpGroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := onCreateGCPGroupScaling(ctx, resourceData, c); err != nil {
+		return diag.Errorf("[ERROR] Failed to set scaling of group [%v]: %v", resourceData.Id(), err)
+	}
+
+	if err := waitForGCPGroupCapacity(ctx, resourceData, meta,
+		elastigroup_gke.WaitForCapacity, elastigroup_gke.WaitForCapacityTimeout); err != nil {
+		return diag.Errorf("[ERROR] Timed out when creating group: %s", err)
+	}
+
 	log.Printf("===> Elastigroup for GKE created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstElastigroupGKERead(ctx, resourceData, meta)
 }
 
-func createGKEGroup(gkeGroup *gcp.Group, spotinstClient *Client) (*string, error) {
+func createGKEGroup(ctx context.Context, resourceData *schema.ResourceData, gkeGroup *gcp.Group, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(gkeGroup); err != nil {
 		return nil, err
 	} else {
@@ -166,9 +183,9 @@ func createGKEGroup(gkeGroup *gcp.Group, spotinstClient *Client) (*string, error
 	}
 
 	var resp *gcp.CreateGroupOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateGroupInput{Group: gkeGroup}
-		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(context.Background(), input)
+		r, err := spotinstClient.elastigroup.CloudProviderGCP().Create(ctx, input)
 		if err != nil {
 
 			// If there's some other error, report it.
@@ -219,6 +236,14 @@ func resourceSpotinstElastigroupGKERead(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
+	c, err := gcpGroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := onReadGCPGroupScaling(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> Elastigroup read successfully: %s <===", groupId)
 	return nil
 }
@@ -238,17 +263,26 @@ func resourceSpotinstElastigroupGKEUpdate(ctx context.Context, resourceData *sch
 	if shouldUpdate {
 		elastigroup.SetID(spotinst.String(groupId))
 
-		if err := updateGKEGroup(elastigroup, resourceData, meta); err != nil {
+		if err := updateGKEGroup(ctx, elastigroup, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	c, err := gcpGroupClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := onUpdateGCPGroupScaling(ctx, resourceData, c); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> Elastigroup updated successfully: %s <===", groupId)
 	return resourceSpotinstElastigroupGKERead(ctx, resourceData, meta)
 }
 
-// updateGKEGroup sends the update request to the Spotinst API and returns an error if the request fails.
-func updateGKEGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
+// updateGKEGroup sends the update request to the Spotinst API, rolls the group when
+// the update policy asks to, and returns an error if any of the requests fails.
+func updateGKEGroup(ctx context.Context, elastigroup *gcp.Group, resourceData *schema.ResourceData, meta interface{}) error {
 	// we need to remove the location and clusterID params used when calling Create.
 	// The core does not support these when calling Update.
 	elastigroup.Integration.SetGKE(&gcp.GKEIntegration{
@@ -265,10 +299,23 @@ func updateGKEGroup(elastigroup *gcp.Group, resourceData *schema.ResourceData, m
 		log.Printf("===> Group update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).elastigroup.CloudProviderGCP().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update group [%v]: %v", groupId, err)
 	}
 
+	if shouldRollElastigroup(resourceData) {
+		if err := rollGCPGroup(ctx, resourceData, meta); err != nil {
+			log.Printf("[ERROR] Group [%v] roll failed, error: %v", groupId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping group roll", string(elastigroup_update_policy.ShouldRoll))
+		if err := waitForGCPGroupCapacity(ctx, resourceData, meta,
+			elastigroup_gke.WaitForCapacity, elastigroup_gke.WaitForCapacityTimeout); err != nil {
+			return fmt.Errorf("[ERROR] Timed out when updating group: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -31,7 +30,8 @@ func resourceSpotinstHealthCheck() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.HealthCheckResource.GetSchemaMap(),
+		Schema:   commons.HealthCheckResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -87,7 +87,7 @@ func resourceSpotinstHealthCheckCreate(ctx context.Context, resourceData *schema
 		return diag.FromErr(err)
 	}
 
-	healthCheckId, err := createHealthCheck(resourceData, healthCheck, meta.(*Client))
+	healthCheckId, err := createHealthCheck(ctx, resourceData, healthCheck, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -100,16 +100,16 @@ func resourceSpotinstHealthCheckCreate(ctx context.Context, resourceData *schema
 
 }
 
-func createHealthCheck(resourceData *schema.ResourceData, healthCheck *healthcheck.HealthCheck, spotinstClient *Client) (*string, error) {
+func createHealthCheck(ctx context.Context, resourceData *schema.ResourceData, healthCheck *healthcheck.HealthCheck, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(healthCheck); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> HealthCheck create configuration: %s", json)
 	}
 	var resp *healthcheck.CreateHealthCheckOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &healthcheck.CreateHealthCheckInput{HealthCheck: healthCheck}
-		r, err := spotinstClient.healthCheck.Create(context.Background(), input)
+		r, err := spotinstClient.healthCheck.Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the HealthCheck creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -40,7 +40,8 @@ func resourceSpotinstMangedInstanceAWS() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.ManagedInstanceResource.GetSchemaMap(),
+		Schema:   commons.ManagedInstanceResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -109,7 +110,7 @@ func resourceSpotinstManagedInstanceAWSCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	ManagedInstanceId, err := createManagedInstance(resourceData, mangedInstance, meta.(*Client))
+	ManagedInstanceId, err := createManagedInstance(ctx, resourceData, mangedInstance, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -121,7 +122,7 @@ func resourceSpotinstManagedInstanceAWSCreate(ctx context.Context, resourceData
 	return resourceSpotinstManagedInstanceAWSRead(ctx, resourceData, meta)
 }
 
-func createManagedInstance(resourceData *schema.ResourceData, mangedInstance *aws.ManagedInstance, spotinstClient *Client) (*string, error) {
+func createManagedInstance(ctx context.Context, resourceData *schema.ResourceData, mangedInstance *aws.ManagedInstance, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(mangedInstance); err != nil {
 		return nil, err
 	} else {
@@ -132,9 +133,9 @@ func createManagedInstance(resourceData *schema.ResourceData, mangedInstance *aw
 	}
 
 	var resp *aws.CreateManagedInstanceOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateManagedInstanceInput{ManagedInstance: mangedInstance}
-		r, err := spotinstClient.managedInstance.CloudProviderAWS().Create(context.Background(), input)
+		r, err := spotinstClient.managedInstance.CloudProviderAWS().Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the group creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -24,6 +24,10 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/mrscaler_aws_terminationPolicies"
 )
 
+// mrScalerReadDelay is how long to wait before reading a scaler, for its
+// cluster to be reflected by the API.
+var mrScalerReadDelay = 10 * time.Second
+
 func resourceSpotinstMRScalerAWS() *schema.Resource {
 	setupMRScalerAWSResource()
 
@@ -37,7 +41,8 @@ func resourceSpotinstMRScalerAWS() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.MRScalerAWSResource.GetSchemaMap(),
+		Schema:   commons.MRScalerAWSResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -64,7 +69,7 @@ func resourceSpotinstMRScalerAWSCreate(ctx context.Context, resourceData *schema
 		return diag.FromErr(err)
 	}
 
-	scalerId, err := createScaler(scaler, meta.(*Client))
+	scalerId, err := createScaler(ctx, resourceData, scaler, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -76,7 +81,7 @@ func resourceSpotinstMRScalerAWSCreate(ctx context.Context, resourceData *schema
 	return resourceSpotinstMRScalerAWSRead(ctx, resourceData, meta)
 }
 
-func createScaler(scaler *mrscaler.Scaler, spotinstClient *Client) (*string, error) {
+func createScaler(ctx context.Context, resourceData *schema.ResourceData, scaler *mrscaler.Scaler, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(scaler); err != nil {
 		return nil, err
 	} else {
@@ -84,9 +89,9 @@ func createScaler(scaler *mrscaler.Scaler, spotinstClient *Client) (*string, err
 	}
 
 	var resp *mrscaler.CreateScalerOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &mrscaler.CreateScalerInput{Scaler: scaler}
-		r, err := spotinstClient.mrscaler.Create(context.Background(), input)
+		r, err := spotinstClient.mrscaler.Create(ctx, input)
 		if err != nil {
 			// Checks whether we should retry the scaler creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -113,7 +118,7 @@ func createScaler(scaler *mrscaler.Scaler, spotinstClient *Client) (*string, err
 
 func resourceSpotinstMRScalerAWSRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
-	time.Sleep(10 * time.Second)
+	time.Sleep(mrScalerReadDelay)
 	log.Printf(string(commons.ResourceOnRead),
 		commons.MRScalerAWSResource.GetName(), id)
 
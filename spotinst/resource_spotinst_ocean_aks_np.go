@@ -36,7 +36,9 @@ func resourceSpotinstOceanAKSNP() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanAKSNPResource.GetSchemaMap(),
+		Schema:        commons.OceanAKSNPResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanAKSNPResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -72,6 +74,12 @@ func resourceSpotinstClusterAKSNPCreate(ctx context.Context, resourceData *schem
 	}
 
 	resourceData.SetId(spotinst.StringValue(clusterID))
+
+	if err := waitForOceanNodes(ctx, resourceData, ocean_aks_np.WaitForNodes, ocean_aks_np.MinCount, ocean_aks_np.Timeout,
+		readOceanAKSNodes(meta.(*Client), resourceData.Id())); err != nil {
+		return diag.Errorf("[ERROR] Timed out when creating cluster: %s", err)
+	}
+
 	log.Printf("ocean/aks: AKS cluster created successfully: %s", resourceData.Id())
 
 	return resourceSpotinstClusterAKSNPRead(ctx, resourceData, meta)
@@ -161,7 +169,8 @@ func resourceSpotinstClusterAKSNPUpdate(ctx context.Context, resourceData *schem
 	clusterID := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAKSNPResource.GetName(), clusterID)
 
-	shouldUpdate, changesRequiredRoll, cluster, err := commons.OceanAKSNPResource.OnUpdate(resourceData, meta)
+	excludedRollParams := getOceanConditionedRollParams(resourceData, ocean_aks_np.UpdatePolicy, ocean_aks_np.ConditionedRollExcludeParams)
+	shouldUpdate, changesRequiredRoll, cluster, err := commons.OceanAKSNPResource.OnUpdate(resourceData, meta, excludedRollParams)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -253,9 +262,21 @@ func rollOceanAKSCluster(resourceData *schema.ResourceData, meta interface{}) er
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &azure_np.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderAzureNP().CreateRoll(context.TODO(), rollInput); err != nil {
+		rollOut, err := meta.(*Client).ocean.CloudProviderAzureNP().CreateRoll(context.TODO(), rollInput)
+		if err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
+
+		if pct, timeout := getOceanRollWaitConfig(rollConfig, ocean_aks_np.WaitForRollPct, ocean_aks_np.WaitForRollTimeout); pct > 0 && timeout > 0 {
+			rollID := ""
+			if rollOut.Roll != nil {
+				rollID = spotinst.StringValue(rollOut.Roll.ID)
+			}
+			if err := awaitOceanRoll(context.TODO(), clusterID, rollID, pct, timeout,
+				readOceanAKSRollStatus(meta.(*Client), clusterID, rollID)); err != nil {
+				return fmt.Errorf("onRoll() -> %v", err)
+			}
+		}
 		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
 	}
 
@@ -34,7 +34,9 @@ func resourceSpotinstOceanAKSNPVirtualNodeGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanAKSNPVirtualNodeGroupResource.GetSchemaMap(),
+		Schema:        commons.OceanAKSNPVirtualNodeGroupResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanAKSNPVirtualNodeGroupResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -153,7 +155,9 @@ func resourceSpotinstOceanAKSNPVirtualNodeGroupUpdate(ctx context.Context, resou
 	virtualNodeGroupID := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAKSNPVirtualNodeGroupResource.GetName(), virtualNodeGroupID)
 
-	shouldUpdate, changesRequiredRoll, virtualNodeGroup, err := commons.OceanAKSNPVirtualNodeGroupResource.OnUpdate(resourceData, meta)
+	conditionedRollParams := getOceanConditionedRollParams(resourceData, ocean_aks_np_virtual_node_group.UpdatePolicy, ocean_aks_np_virtual_node_group.ConditionedRollParams)
+	excludedRollParams := getOceanConditionedRollParams(resourceData, ocean_aks_np_virtual_node_group.UpdatePolicy, ocean_aks_np_virtual_node_group.ConditionedRollExcludeParams)
+	shouldUpdate, changesRequiredRoll, virtualNodeGroup, err := commons.OceanAKSNPVirtualNodeGroupResource.OnUpdate(resourceData, meta, conditionedRollParams, excludedRollParams)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -36,7 +36,12 @@ func resourceSpotinstOceanAWS() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.OceanAWSResource.GetSchemaMap(),
+		Schema:        commons.OceanAWSResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanAWSResource.CustomizeDiff,
+
+		SchemaVersion:  commons.OceanAWSResource.SchemaVersion(),
+		StateUpgraders: commons.OceanAWSResource.StateUpgraders(),
+		Timeouts:       commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -63,18 +68,23 @@ func resourceSpotinstClusterAWSCreate(ctx context.Context, resourceData *schema.
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createAWSCluster(resourceData, cluster, meta.(*Client))
+	clusterID, err := createAWSCluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(clusterID))
 
+	if err := waitForOceanNodes(ctx, resourceData, ocean_aws.WaitForNodes, ocean_aws.MinCount, ocean_aws.Timeout,
+		readOceanAWSNodes(meta.(*Client), resourceData.Id())); err != nil {
+		return diag.Errorf("[ERROR] Timed out when creating cluster: %s", err)
+	}
+
 	log.Printf("===> Cluster created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstClusterAWSRead(ctx, resourceData, meta)
 }
 
-func createAWSCluster(resourceData *schema.ResourceData, cluster *aws.Cluster, spotinstClient *Client) (*string, error) {
+func createAWSCluster(ctx context.Context, resourceData *schema.ResourceData, cluster *aws.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -87,9 +97,9 @@ func createAWSCluster(resourceData *schema.ResourceData, cluster *aws.Cluster, s
 	}
 
 	var resp *aws.CreateClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateCluster(ctx, input)
 		if err != nil {
 			// Checks whether we should retry cluster creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -304,9 +314,21 @@ func rollOceanAWSCluster(resourceData *schema.ResourceData, meta interface{}) er
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &aws.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderAWS().CreateRoll(context.TODO(), rollInput); err != nil {
+		rollOut, err := meta.(*Client).ocean.CloudProviderAWS().CreateRoll(context.TODO(), rollInput)
+		if err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
+
+		if pct, timeout := getOceanRollWaitConfig(rollConfig, ocean_aws.WaitForRollPct, ocean_aws.WaitForRollTimeout); pct > 0 && timeout > 0 {
+			rollID := ""
+			if rollOut.Roll != nil {
+				rollID = spotinst.StringValue(rollOut.Roll.ID)
+			}
+			if err := awaitOceanRoll(context.TODO(), clusterID, rollID, pct, timeout,
+				readOceanAWSRollStatus(meta.(*Client), clusterID, rollID)); err != nil {
+				return fmt.Errorf("onRoll() -> %v", err)
+			}
+		}
 		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
 	}
 
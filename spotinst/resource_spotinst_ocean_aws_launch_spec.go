@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -31,7 +30,9 @@ func resourceSpotinstOceanAWSLaunchSpec() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanAWSLaunchSpecResource.GetSchemaMap(),
+		Schema:        commons.OceanAWSLaunchSpecResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanAWSLaunchSpecResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -50,7 +51,7 @@ func resourceSpotinstOceanAWSLaunchSpecCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	launchSpecId, err := createLaunchSpec(resourceData, launchSpec, meta.(*Client))
+	launchSpecId, err := createLaunchSpec(ctx, resourceData, launchSpec, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,7 +60,7 @@ func resourceSpotinstOceanAWSLaunchSpecCreate(ctx context.Context, resourceData
 	return resourceSpotinstOceanAWSLaunchSpecRead(ctx, resourceData, meta)
 }
 
-func createLaunchSpec(resourceData *schema.ResourceData, launchSpec *aws.LaunchSpec, spotinstClient *Client) (*string, error) {
+func createLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, launchSpec *aws.LaunchSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return nil, err
 	} else {
@@ -67,7 +68,7 @@ func createLaunchSpec(resourceData *schema.ResourceData, launchSpec *aws.LaunchS
 	}
 
 	var resp *aws.CreateLaunchSpecOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateLaunchSpecInput{LaunchSpec: launchSpec}
 		if createOptions, exists := resourceData.GetOkExists(string(ocean_aws_launch_spec.CreateOptions)); exists {
 			list := createOptions.([]interface{})
@@ -78,7 +79,7 @@ func createLaunchSpec(resourceData *schema.ResourceData, launchSpec *aws.LaunchS
 				}
 			}
 		}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateLaunchSpec(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateLaunchSpec(ctx, input)
 		if err != nil {
 			// Checks whether we should retry launchSpec creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -145,14 +146,16 @@ func resourceSpotinstOceanAWSLaunchSpecUpdate(ctx context.Context, resourceData
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAWSLaunchSpecResource.GetName(), id)
 
-	shouldUpdate, launchSpec, err := commons.OceanAWSLaunchSpecResource.OnUpdate(resourceData, meta)
+	conditionedRollParams := getOceanConditionedRollParams(resourceData, ocean_aws_launch_spec.UpdatePolicy, ocean_aws_launch_spec.ConditionedRollParams)
+	excludedRollParams := getOceanConditionedRollParams(resourceData, ocean_aws_launch_spec.UpdatePolicy, ocean_aws_launch_spec.ConditionedRollExcludeParams)
+	shouldUpdate, changesRequiredRoll, launchSpec, err := commons.OceanAWSLaunchSpecResource.OnUpdate(resourceData, meta, conditionedRollParams, excludedRollParams)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
 		launchSpec.SetId(spotinst.String(id))
-		if err := updateLaunchSpec(launchSpec, resourceData, meta); err != nil {
+		if err := updateLaunchSpec(launchSpec, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -160,7 +163,7 @@ func resourceSpotinstOceanAWSLaunchSpecUpdate(ctx context.Context, resourceData
 	return resourceSpotinstOceanAWSLaunchSpecRead(ctx, resourceData, meta)
 }
 
-func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceData, meta interface{}) error {
+func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &aws.UpdateLaunchSpecInput{
 		LaunchSpec: launchSpec,
 	}
@@ -168,6 +171,7 @@ func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceD
 	launchSpecId := resourceData.Id()
 	oceanId := resourceData.Get(string(ocean_aws_launch_spec.OceanID))
 	var shouldRoll = false
+	var conditionedRoll = false
 	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_aws_launch_spec.UpdatePolicy)); exists {
 		list := updatePolicy.([]interface{})
 		if len(list) > 0 && list[0] != nil {
@@ -176,6 +180,10 @@ func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceD
 			if roll, ok := m[string(ocean_aws_launch_spec.ShouldRoll)].(bool); ok && roll {
 				shouldRoll = roll
 			}
+
+			if condRoll, ok := m[string(ocean_aws_launch_spec.ConditionedRoll)].(bool); ok && condRoll {
+				conditionedRoll = condRoll
+			}
 		}
 	}
 
@@ -188,9 +196,11 @@ func updateLaunchSpec(launchSpec *aws.LaunchSpec, resourceData *schema.ResourceD
 	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateLaunchSpec(context.Background(), input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update launchSpec [%v]: %v", launchSpecId, err)
 	} else if shouldRoll {
-		if err := rollOceanAWSLaunchSpec(resourceData, meta); err != nil {
-			log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
-			return err
+		if !conditionedRoll || changesRequiredRoll {
+			if err := rollOceanAWSLaunchSpec(resourceData, meta); err != nil {
+				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
+				return err
+			}
 		}
 	} else {
 		log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_aws_launch_spec.ShouldRoll))
@@ -232,9 +242,21 @@ func rollOceanAWSLaunchSpec(resourceData *schema.ResourceData, meta interface{})
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &aws.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderAWS().CreateRoll(context.TODO(), rollInput); err != nil {
+		rollOut, err := meta.(*Client).ocean.CloudProviderAWS().CreateRoll(context.TODO(), rollInput)
+		if err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
+
+		if pct, timeout := getOceanRollWaitConfig(rollConfig, ocean_aws_launch_spec.WaitForRollPct, ocean_aws_launch_spec.WaitForRollTimeout); pct > 0 && timeout > 0 {
+			rollID := ""
+			if rollOut.Roll != nil {
+				rollID = spotinst.StringValue(rollOut.Roll.ID)
+			}
+			if err := awaitOceanRoll(context.TODO(), clusterID, rollID, pct, timeout,
+				readOceanAWSRollStatus(meta.(*Client), clusterID, rollID)); err != nil {
+				return fmt.Errorf("onRoll() -> %v", err)
+			}
+		}
 		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
 	}
 
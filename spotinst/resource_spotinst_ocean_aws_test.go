@@ -1057,6 +1057,8 @@ func TestAccSpotinstOceanAWS_UpdatePolicy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "66"),
 					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_min_healthy_percentage", "30"),
 					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.respect_pdb", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.wait_for_roll_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.wait_for_roll_timeout", "1800"),
 				),
 			},
 			{
@@ -1105,6 +1107,8 @@ const testUpdatePolicyAWSClusterConfig_Update = `
       	batch_size_percentage = 66
 		batch_min_healthy_percentage = 30
 		respect_pdb = true
+		wait_for_roll_percentage = 50
+		wait_for_roll_timeout = 1800
     }
   }
  // ----------------------------------
@@ -1179,3 +1183,101 @@ const testLoggingAWSConfig_EmptyFields = `
 `
 
 // endregion
+
+func TestOceanAWS_ValidateDiff(t *testing.T) {
+	api := newFakeAPI(t)
+	r := resourceSpotinstOceanAWS()
+
+	rollConfig := func(m map[string]interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"should_roll": true,
+			"roll_config": []interface{}{m},
+		}}
+	}
+
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		want   string
+	}{
+		{name: "valid capacity",
+			config: map[string]interface{}{"min_size": 0, "desired_capacity": 1, "max_size": 2}},
+		{name: "min greater than max",
+			config: map[string]interface{}{"min_size": 3, "desired_capacity": 3, "max_size": 2},
+			want:   `"min_size" (3) must be less than or equal to "max_size" (2)`},
+		{name: "desired greater than zero max",
+			config: map[string]interface{}{"min_size": 0, "desired_capacity": 1, "max_size": 0},
+			want:   `"desired_capacity" (1) must be less than or equal to "max_size" (0)`},
+		{name: "desired less than min",
+			config: map[string]interface{}{"min_size": 2, "desired_capacity": 1},
+			want:   `"min_size" (2) must be less than or equal to "desired_capacity" (1)`},
+		{name: "valid roll config",
+			config: map[string]interface{}{"update_policy": rollConfig(map[string]interface{}{
+				"batch_size_percentage": 20, "wait_for_roll_percentage": 100, "wait_for_roll_timeout": 900,
+			})}},
+		{name: "batch min healthy percentage out of range",
+			config: map[string]interface{}{"update_policy": rollConfig(map[string]interface{}{
+				"batch_size_percentage": 20, "batch_min_healthy_percentage": 101,
+			})},
+			want: "must be between 0 and 100, got: 101"},
+		{name: "wait for roll timeout without percentage",
+			config: map[string]interface{}{"update_policy": rollConfig(map[string]interface{}{
+				"batch_size_percentage": 20, "wait_for_roll_timeout": 900,
+			})},
+			want: "wait_for_roll_percentage\" must be set together with"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{"name": "cluster", "region": "us-west-2"}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), api.Client())
+			if tc.want == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.want != "" && (err == nil || !strings.Contains(err.Error(), tc.want)) {
+				t.Errorf("got %v, want an error containing %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestOceanAWS_StateUpgradeV0(t *testing.T) {
+	r := resourceSpotinstOceanAWS()
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 {
+		t.Fatalf("got schema version %d with %d upgraders, want 1 with 1", r.SchemaVersion, len(r.StateUpgraders))
+	}
+
+	cases := map[string]struct {
+		userData interface{}
+		want     interface{}
+	}{
+		"empty sha1 sum": {userData: "da39a3ee5e6b4b0d3255bfef95601890afd80709", want: ""},
+		"user data":      {userData: "ZWNobyBoZWxsbw==", want: "ZWNobyBoZWxsbw=="},
+		"null":           {userData: nil, want: nil},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rawState := map[string]interface{}{
+				"id":        "o-12345678",
+				"name":      "cluster",
+				"user_data": tc.userData,
+			}
+
+			got, err := r.StateUpgraders[0].Upgrade(context.Background(), rawState, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got["user_data"] != tc.want {
+				t.Errorf("user_data: got %#v, want %#v", got["user_data"], tc.want)
+			}
+			if got["name"] != "cluster" {
+				t.Errorf("name: got %#v, want the prior value", got["name"])
+			}
+		})
+	}
+}
@@ -39,7 +39,9 @@ func resourceSpotinstOceanECS() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.OceanECSResource.GetSchemaMap(),
+		Schema:        commons.OceanECSResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanECSResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -66,7 +68,7 @@ func resourceSpotinstClusterECSCreate(ctx context.Context, resourceData *schema.
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createECSCluster(resourceData, cluster, meta.(*Client))
+	clusterID, err := createECSCluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -77,7 +79,7 @@ func resourceSpotinstClusterECSCreate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterECSRead(ctx, resourceData, meta)
 }
 
-func createECSCluster(resourceData *schema.ResourceData, cluster *aws.ECSCluster, spotinstClient *Client) (*string, error) {
+func createECSCluster(ctx context.Context, resourceData *schema.ResourceData, cluster *aws.ECSCluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -90,9 +92,9 @@ func createECSCluster(resourceData *schema.ResourceData, cluster *aws.ECSCluster
 	}
 
 	var resp *aws.CreateECSClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateECSClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSCluster(ctx, input)
 		if err != nil {
 			// Checks whether we should retry cluster creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -245,12 +247,22 @@ func rollECSCluster(resourceData *schema.ResourceData, meta interface{}) error {
 					} else {
 						log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, json)
 						rollClusterInput.Roll.ClusterID = spotinst.String(clusterID)
-						_, err := meta.(*Client).ocean.CloudProviderAWS().RollECS(context.Background(), rollClusterInput)
+						rollOut, err := meta.(*Client).ocean.CloudProviderAWS().RollECS(context.Background(), rollClusterInput)
 						if err != nil {
 							return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
-						} else {
-							log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
 						}
+
+						if pct, timeout := getOceanRollWaitConfig(rollConfig, ocean_ecs.WaitForRollPct, ocean_ecs.WaitForRollTimeout); pct > 0 && timeout > 0 {
+							rollID := ""
+							if rollOut.RollClusterStatus != nil {
+								rollID = spotinst.StringValue(rollOut.RollClusterStatus.RollID)
+							}
+							if err := awaitOceanRoll(context.Background(), clusterID, rollID, pct, timeout,
+								readOceanECSRollStatus(meta.(*Client), clusterID, rollID)); err != nil {
+								return fmt.Errorf("onRoll() -> %v", err)
+							}
+						}
+						log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
 					}
 				}
 			}
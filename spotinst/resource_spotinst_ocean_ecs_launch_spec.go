@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -31,7 +30,9 @@ func resourceSpotinstOceanECSLaunchSpec() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanECSLaunchSpecResource.GetSchemaMap(),
+		Schema:        commons.OceanECSLaunchSpecResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanECSLaunchSpecResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -50,7 +51,7 @@ func resourceSpotinstOceanECSLaunchSpecCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	launchSpecId, err := createECSLaunchSpec(launchSpec, meta.(*Client))
+	launchSpecId, err := createECSLaunchSpec(ctx, resourceData, launchSpec, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -59,7 +60,7 @@ func resourceSpotinstOceanECSLaunchSpecCreate(ctx context.Context, resourceData
 	return resourceSpotinstOceanECSLaunchSpecRead(ctx, resourceData, meta)
 }
 
-func createECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, spotinstClient *Client) (*string, error) {
+func createECSLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, launchSpec *aws.ECSLaunchSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return nil, err
 	} else {
@@ -67,9 +68,9 @@ func createECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, spotinstClient *Client)
 	}
 
 	var resp *aws.CreateECSLaunchSpecOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateECSLaunchSpecInput{LaunchSpec: launchSpec}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSLaunchSpec(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateECSLaunchSpec(ctx, input)
 		if err != nil {
 			// Checks whether we should retry launchSpec creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -156,6 +157,18 @@ func updateECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, resourceData *schema.Res
 	}
 
 	launchSpecId := resourceData.Id()
+	oceanId := resourceData.Get(string(ocean_ecs_launch_spec.OceanID))
+	var shouldRoll = false
+	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_ecs_launch_spec.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+
+			if roll, ok := m[string(ocean_ecs_launch_spec.ShouldRoll)].(bool); ok && roll {
+				shouldRoll = roll
+			}
+		}
+	}
 
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return err
@@ -165,11 +178,91 @@ func updateECSLaunchSpec(launchSpec *aws.ECSLaunchSpec, resourceData *schema.Res
 
 	if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateECSLaunchSpec(context.Background(), input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update launchSpec [%v]: %v", launchSpecId, err)
+	} else if shouldRoll {
+		if err := rollOceanECSLaunchSpec(resourceData, meta); err != nil {
+			log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
+			return err
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_ecs_launch_spec.ShouldRoll))
+	}
+
+	return nil
+}
+
+// rollOceanECSLaunchSpec rolls the instances of the launch spec only, through
+// the roll of its ECS cluster.
+func rollOceanECSLaunchSpec(resourceData *schema.ResourceData, meta interface{}) error {
+	specID := resourceData.Id()
+	clusterID := resourceData.Get(string(ocean_ecs_launch_spec.OceanID)).(string)
+
+	updatePolicy, exists := resourceData.GetOkExists(string(ocean_ecs_launch_spec.UpdatePolicy))
+	if !exists {
+		return fmt.Errorf("ocean/ecs: missing update policy for cluster %q", clusterID)
+	}
+
+	list := updatePolicy.([]interface{})
+	if len(list) > 0 && list[0] != nil {
+		updateClusterSchema := list[0].(map[string]interface{})
+
+		rollConfig, ok := updateClusterSchema[string(ocean_ecs_launch_spec.RollConfig)]
+		if !ok || rollConfig == nil || len(rollConfig.([]interface{})) == 0 {
+			return fmt.Errorf("ocean/ecs: missing roll configuration, "+
+				"skipping roll for cluster %q", clusterID)
+		}
+
+		rollInput := expandOceanECSLaunchSpecRollConfig(rollConfig, clusterID, specID)
+
+		rollJSON, err := commons.ToJson(rollConfig)
+		if err != nil {
+			return fmt.Errorf("ocean/ecs: failed marshaling roll "+
+				"configuration for cluster %q, error: %v", clusterID, err)
+		}
+
+		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
+		rollOut, err := meta.(*Client).ocean.CloudProviderAWS().RollECS(context.TODO(), rollInput)
+		if err != nil {
+			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
+		}
+
+		if pct, timeout := getOceanRollWaitConfig(rollConfig, ocean_ecs_launch_spec.WaitForRollPct, ocean_ecs_launch_spec.WaitForRollTimeout); pct > 0 && timeout > 0 {
+			rollID := ""
+			if rollOut.RollClusterStatus != nil {
+				rollID = spotinst.StringValue(rollOut.RollClusterStatus.RollID)
+			}
+			if err := awaitOceanRoll(context.TODO(), clusterID, rollID, pct, timeout,
+				readOceanECSRollStatus(meta.(*Client), clusterID, rollID)); err != nil {
+				return fmt.Errorf("onRoll() -> %v", err)
+			}
+		}
+		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
 	}
 
 	return nil
 }
 
+func expandOceanECSLaunchSpecRollConfig(data interface{}, clusterID, specID string) *aws.ECSRollClusterInput {
+	list := data.([]interface{})
+	roll := &aws.ECSRoll{
+		ClusterID:     spotinst.String(clusterID),
+		LaunchSpecIDs: []string{specID},
+	}
+
+	if list != nil && list[0] != nil {
+		m := list[0].(map[string]interface{})
+
+		if v, ok := m[string(ocean_ecs_launch_spec.BatchSizePercentage)].(int); ok {
+			roll.BatchSizePercentage = spotinst.Int(v)
+		}
+
+		if v, ok := m[string(ocean_ecs_launch_spec.BatchMinHealthyPercentage)].(int); ok && v > 0 {
+			roll.BatchMinHealthyPercentage = spotinst.Int(v)
+		}
+	}
+
+	return &aws.ECSRollClusterInput{Roll: roll}
+}
+
 func resourceSpotinstOceanECSLaunchSpecDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete),
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -30,7 +29,8 @@ func resourceSpotinstOceanAWSExtendedResourceDefinition() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanAWSExtendedResourceDefinitionResource.GetSchemaMap(),
+		Schema:   commons.OceanAWSExtendedResourceDefinitionResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -85,7 +85,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionCreate(ctx context.Contex
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	extendedResourceDefinitionId, err := createOceanAWSExtendedResourceDefinition(resourceData, extendedResourceDefinition, meta.(*Client))
+	extendedResourceDefinitionId, err := createOceanAWSExtendedResourceDefinition(ctx, resourceData, extendedResourceDefinition, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,16 +98,16 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionCreate(ctx context.Contex
 
 }
 
-func createOceanAWSExtendedResourceDefinition(resourceData *schema.ResourceData, erd *aws.ExtendedResourceDefinition, spotinstClient *Client) (*string, error) {
+func createOceanAWSExtendedResourceDefinition(ctx context.Context, resourceData *schema.ResourceData, erd *aws.ExtendedResourceDefinition, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(erd); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> ExtendedResourceDefinition create configuration: %s", json)
 	}
 	var resp *aws.CreateExtendedResourceDefinitionOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &aws.CreateExtendedResourceDefinitionInput{ExtendedResourceDefinition: erd}
-		r, err := spotinstClient.ocean.CloudProviderAWS().CreateExtendedResourceDefinition(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderAWS().CreateExtendedResourceDefinition(ctx, input)
 		if err != nil {
 
 			// Some other error, report it.
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -17,7 +16,9 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_auto_scaling"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_instance_types"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_launch_configuration"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_network_interface"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_scheduling"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_strategy"
 )
 
@@ -33,7 +34,9 @@ func resourceSpotinstOceanGKE() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.OceanGKEResource.GetSchemaMap(),
+		Schema:        commons.OceanGKEResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanGKEResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -43,7 +46,9 @@ func setupClusterGKEResource() {
 	ocean_gke.Setup(fieldsMap)
 	ocean_gke_auto_scaling.Setup(fieldsMap)
 	ocean_gke_instance_types.Setup(fieldsMap)
+	ocean_gke_launch_configuration.Setup(fieldsMap)
 	ocean_gke_network_interface.Setup(fieldsMap)
+	ocean_gke_scheduling.Setup(fieldsMap)
 	ocean_gke_strategy.Setup(fieldsMap)
 
 	commons.OceanGKEResource = commons.NewOceanGKEResource(fieldsMap)
@@ -58,18 +63,18 @@ func resourceSpotinstClusterGKECreate(ctx context.Context, resourceData *schema.
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createGKECluster(cluster, meta.(*Client))
+	clusterID, err := createGKECluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(clusterID))
 
-	log.Printf("===> Elastigroup created successfully: %s <===", resourceData.Id())
+	log.Printf("===> Cluster created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstClusterGKERead(ctx, resourceData, meta)
 }
 
-func createGKECluster(cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
+func createGKECluster(ctx context.Context, resourceData *schema.ResourceData, cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -77,9 +82,9 @@ func createGKECluster(cluster *gcp.Cluster, spotinstClient *Client) (*string, er
 	}
 
 	var resp *gcp.CreateClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(ctx, input)
 		if err != nil {
 
 			// Some other error, report it.
@@ -138,14 +143,14 @@ func resourceSpotinstClusterGKEUpdate(ctx context.Context, resourceData *schema.
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.OceanGKEResource.GetName(), id)
 
-	shouldUpdate, cluster, err := commons.OceanGKEResource.OnUpdate(resourceData, meta)
+	shouldUpdate, changesRequiredRoll, cluster, err := commons.OceanGKEResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
 		cluster.SetId(spotinst.String(id))
-		if err := updateGKECluster(cluster, resourceData, meta); err != nil {
+		if err := updateGKECluster(cluster, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -153,12 +158,28 @@ func resourceSpotinstClusterGKEUpdate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterGKERead(ctx, resourceData, meta)
 }
 
-func updateGKECluster(cluster *gcp.Cluster, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGKECluster(cluster *gcp.Cluster, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &gcp.UpdateClusterInput{
 		Cluster: cluster,
 	}
 
+	var shouldRoll = false
+	var conditionedRoll = false
 	clusterID := resourceData.Id()
+	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_gke.UpdatePolicy)); exists {
+		list := updatePolicy.([]interface{})
+		if len(list) > 0 && list[0] != nil {
+			m := list[0].(map[string]interface{})
+
+			if roll, ok := m[string(ocean_gke.ShouldRoll)].(bool); ok && roll {
+				shouldRoll = roll
+			}
+
+			if condRoll, ok := m[string(ocean_gke.ConditionedRoll)].(bool); ok && condRoll {
+				conditionedRoll = condRoll
+			}
+		}
+	}
 
 	if json, err := commons.ToJson(cluster); err != nil {
 		return err
@@ -168,6 +189,15 @@ func updateGKECluster(cluster *gcp.Cluster, resourceData *schema.ResourceData, m
 
 	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateCluster(context.Background(), input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update cluster [%v]: %v", clusterID, err)
+	} else if shouldRoll {
+		if !conditionedRoll || changesRequiredRoll {
+			if err := rollOceanGKECluster(resourceData, meta); err != nil {
+				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", clusterID, err)
+				return err
+			}
+		}
+	} else {
+		log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_gke.ShouldRoll))
 	}
 
 	return nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -33,7 +32,9 @@ func resourceSpotinstOceanGKEImport() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.OceanGKEImportResource.GetSchemaMap(),
+		Schema:        commons.OceanGKEImportResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanGKEImportResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -89,18 +90,23 @@ func resourceSpotinstClusterGKEImportCreate(ctx context.Context, resourceData *s
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createGKEImportedCluster(cluster, meta.(*Client))
+	clusterID, err := createGKEImportedCluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(clusterID))
 
+	if err := waitForOceanNodes(ctx, resourceData, ocean_gke_import.WaitForNodes, ocean_gke_import.MinCount, ocean_gke_import.Timeout,
+		readOceanGKENodes(meta.(*Client), resourceData.Id())); err != nil {
+		return diag.Errorf("[ERROR] Timed out when creating cluster: %s", err)
+	}
+
 	log.Printf("===> GKE imported cluster created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstClusterGKEImportRead(ctx, resourceData, meta)
 }
 
-func createGKEImportedCluster(cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
+func createGKEImportedCluster(ctx context.Context, resourceData *schema.ResourceData, cluster *gcp.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -108,9 +114,9 @@ func createGKEImportedCluster(cluster *gcp.Cluster, spotinstClient *Client) (*st
 	}
 
 	var resp *gcp.CreateClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateClusterInput{Cluster: cluster}
-		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.CloudProviderGCP().CreateCluster(ctx, input)
 		if err != nil {
 
 			// Some other error, report it.
@@ -305,9 +311,21 @@ func rollOceanGKECluster(resourceData *schema.ResourceData, meta interface{}) er
 
 		log.Printf("onRoll() -> Rolling cluster [%v] with configuration %s", clusterID, rollJSON)
 		rollInput := &gcp.CreateRollInput{Roll: rollSpec}
-		if _, err = meta.(*Client).ocean.CloudProviderGCP().CreateRoll(context.TODO(), rollInput); err != nil {
+		rollOut, err := meta.(*Client).ocean.CloudProviderGCP().CreateRoll(context.TODO(), rollInput)
+		if err != nil {
 			return fmt.Errorf("onRoll() -> Roll failed for cluster [%v], error: %v", clusterID, err)
 		}
+
+		if pct, timeout := getOceanRollWaitConfig(rollConfig, ocean_gke_import.WaitForRollPct, ocean_gke_import.WaitForRollTimeout); pct > 0 && timeout > 0 {
+			rollID := ""
+			if rollOut.Roll != nil {
+				rollID = spotinst.StringValue(rollOut.Roll.RollID)
+			}
+			if err := awaitOceanRoll(context.TODO(), clusterID, rollID, pct, timeout,
+				readOceanGKERollStatus(meta.(*Client), clusterID, rollID)); err != nil {
+				return fmt.Errorf("onRoll() -> %v", err)
+			}
+		}
 		log.Printf("onRoll() -> Successfully rolled cluster [%v]", clusterID)
 	}
 
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
@@ -34,7 +33,9 @@ func resourceSpotinstOceanGKELaunchSpec() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanGKELaunchSpecResource.GetSchemaMap(),
+		Schema:        commons.OceanGKELaunchSpecResource.GetSchemaMap(),
+		CustomizeDiff: commons.OceanGKELaunchSpecResource.CustomizeDiff,
+		Timeouts:      commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -68,7 +69,7 @@ func resourceSpotinstOceanGKELaunchSpecCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	launchSpecId, err := createGKELaunchSpec(resourceData, launchSpec, meta.(*Client))
+	launchSpecId, err := createGKELaunchSpec(ctx, resourceData, launchSpec, meta.(*Client))
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -79,7 +80,7 @@ func resourceSpotinstOceanGKELaunchSpecCreate(ctx context.Context, resourceData
 	return resourceSpotinstOceanGKELaunchSpecRead(ctx, resourceData, meta)
 }
 
-func createGKELaunchSpec(resourceData *schema.ResourceData, launchSpec *gcp.LaunchSpec, spotinstClient *Client) (*string, error) {
+func createGKELaunchSpec(ctx context.Context, resourceData *schema.ResourceData, launchSpec *gcp.LaunchSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(launchSpec); err != nil {
 		return nil, err
 	} else {
@@ -87,7 +88,7 @@ func createGKELaunchSpec(resourceData *schema.ResourceData, launchSpec *gcp.Laun
 	}
 
 	var resp *gcp.CreateLaunchSpecOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &gcp.CreateLaunchSpecInput{LaunchSpec: launchSpec}
 		if createOptions, exists := resourceData.GetOkExists(string(ocean_gke_launch_spec.CreateOptions)); exists {
 			list := createOptions.([]interface{})
@@ -98,7 +99,7 @@ func createGKELaunchSpec(resourceData *schema.ResourceData, launchSpec *gcp.Laun
 				}
 			}
 		}
-		out, err := spotinstClient.ocean.CloudProviderGCP().CreateLaunchSpec(context.Background(), input)
+		out, err := spotinstClient.ocean.CloudProviderGCP().CreateLaunchSpec(ctx, input)
 		if err != nil {
 			// Checks whether we should retry launchSpec creation.
 			if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
@@ -188,14 +189,16 @@ func resourceSpotinstOceanGKELaunchSpecUpdate(ctx context.Context, resourceData
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanGKELaunchSpecResource.GetName(), id)
 
-	shouldUpdate, launchSpec, err := commons.OceanGKELaunchSpecResource.OnUpdate(resourceData, meta)
+	conditionedRollParams := getOceanConditionedRollParams(resourceData, ocean_gke_launch_spec.UpdatePolicy, ocean_gke_launch_spec.ConditionedRollParams)
+	excludedRollParams := getOceanConditionedRollParams(resourceData, ocean_gke_launch_spec.UpdatePolicy, ocean_gke_launch_spec.ConditionedRollExcludeParams)
+	shouldUpdate, changesRequiredRoll, launchSpec, err := commons.OceanGKELaunchSpecResource.OnUpdate(resourceData, meta, conditionedRollParams, excludedRollParams)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
 		launchSpec.SetId(spotinst.String(id))
-		if err := updateGKELaunchSpec(launchSpec, resourceData, meta); err != nil {
+		if err := updateGKELaunchSpec(launchSpec, resourceData, meta, changesRequiredRoll); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -203,7 +206,7 @@ func resourceSpotinstOceanGKELaunchSpecUpdate(ctx context.Context, resourceData
 	return resourceSpotinstOceanGKELaunchSpecRead(ctx, resourceData, meta)
 }
 
-func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.ResourceData, meta interface{}) error {
+func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.ResourceData, meta interface{}, changesRequiredRoll bool) error {
 	var input = &gcp.UpdateLaunchSpecInput{
 		LaunchSpec: launchSpec,
 	}
@@ -212,6 +215,7 @@ func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.Resour
 
 	oceanId := resourceData.Get(string(ocean_gke_launch_spec.OceanId))
 	var shouldRoll = false
+	var conditionedRoll = false
 	if updatePolicy, exists := resourceData.GetOkExists(string(ocean_gke_launch_spec.UpdatePolicy)); exists {
 		list := updatePolicy.([]interface{})
 		if len(list) > 0 && list[0] != nil {
@@ -220,6 +224,10 @@ func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.Resour
 			if roll, ok := m[string(ocean_gke_launch_spec.ShouldRoll)].(bool); ok && roll {
 				shouldRoll = roll
 			}
+
+			if condRoll, ok := m[string(ocean_gke_launch_spec.ConditionedRoll)].(bool); ok && condRoll {
+				conditionedRoll = condRoll
+			}
 		}
 	}
 
@@ -232,9 +240,11 @@ func updateGKELaunchSpec(launchSpec *gcp.LaunchSpec, resourceData *schema.Resour
 	if _, err := meta.(*Client).ocean.CloudProviderGCP().UpdateLaunchSpec(context.Background(), input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update launchSpec GKE [%v]: %v", launchSpecId, err)
 	} else if shouldRoll {
-		if err := rollOceanGKELaunchSpec(resourceData, meta); err != nil {
-			log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
-			return err
+		if !conditionedRoll || changesRequiredRoll {
+			if err := rollOceanGKELaunchSpec(resourceData, meta); err != nil {
+				log.Printf("[ERROR] Cluster [%v] roll failed, error: %v", oceanId, err)
+				return err
+			}
 		}
 	} else {
 		log.Printf("onRoll() -> Field [%v] is false, skipping cluster roll", string(ocean_gke_launch_spec.ShouldRoll))
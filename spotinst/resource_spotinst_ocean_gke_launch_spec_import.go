@@ -28,7 +28,8 @@ func resourceSpotinstOceanGKELaunchSpecImport() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanGKELaunchSpecImportResource.GetSchemaMap(),
+		Schema:   commons.OceanGKELaunchSpecImportResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -0,0 +1,486 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanGKEResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanGKEResourceName), name)
+}
+
+func testOceanGKEDestroy(s *terraform.State) error {
+	client := testAccProviderGCP.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OceanGKEResourceName) {
+			continue
+		}
+		input := &gcp.ReadClusterInput{ClusterID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderGCP().ReadCluster(context.Background(), input)
+		if err == nil && resp != nil && resp.Cluster != nil {
+			return fmt.Errorf("cluster still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckOceanGKEAttributes(cluster *gcp.Cluster, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if spotinst.StringValue(cluster.Name) != expectedName {
+			return fmt.Errorf("bad content: %v", cluster.Name)
+		}
+		return nil
+	}
+}
+
+func testCheckOceanGKEExists(cluster *gcp.Cluster, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderGCP.Meta().(*Client)
+		input := &gcp.ReadClusterInput{ClusterID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderGCP().ReadCluster(context.Background(), input)
+		if err != nil {
+			return err
+		}
+		if spotinst.StringValue(resp.Cluster.Name) != rs.Primary.Attributes["name"] {
+			return fmt.Errorf("Cluster not found: %+v,\n %+v\n", resp.Cluster, rs.Primary.Attributes)
+		}
+		*cluster = *resp.Cluster
+		return nil
+	}
+}
+
+type OceanGKEMetadata struct {
+	clusterName          string
+	provider             string
+	fieldsToAppend       string
+	updateBaselineFields bool
+}
+
+func createOceanGKETerraform(clusterMeta *OceanGKEMetadata) string {
+	if clusterMeta == nil {
+		return ""
+	}
+
+	if clusterMeta.provider == "" {
+		clusterMeta.provider = "gcp"
+	}
+
+	template :=
+		`provider "gcp" {
+	token   = "fake"
+	account = "fake"
+	}
+	`
+	format := testBaselineOceanGKEConfig_Create
+	if clusterMeta.updateBaselineFields {
+		format = testBaselineOceanGKEConfig_Update
+	}
+
+	template += fmt.Sprintf(format,
+		clusterMeta.clusterName,
+		clusterMeta.provider,
+		clusterMeta.clusterName,
+		clusterMeta.fieldsToAppend,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", clusterMeta.clusterName, template)
+	return template
+}
+
+// region Ocean GKE: Baseline
+func TestAccSpotinstOceanGKE_Baseline(t *testing.T) {
+	clusterName := "terraform-acc-tests-ocean-gke-baseline"
+	resourceName := createOceanGKEResourceName(clusterName)
+
+	var cluster gcp.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName: clusterName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "0"),
+					resource.TestCheckResourceAttr(resourceName, "desired_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.0", "n1-standard-1"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_type", "pd-ssd"),
+				),
+			},
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:          clusterName,
+					updateBaselineFields: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "desired_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.0", "n1-standard-1"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.1", "n1-standard-2"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_type", "pd-standard"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOceanGKEConfig_Create = `
+resource "` + string(commons.OceanGKEResourceName) + `" "%v" {
+  provider = "%v"
+
+  name            = "%v"
+  controller_id   = "terraform-tests-do-not-delete"
+  cluster_name    = "terraform-tests-do-not-delete"
+  master_location = "us-central1-a"
+
+  availability_zones = ["us-central1-a"]
+  subnet_name        = "default"
+  source_image       = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+
+  metadata {
+    key   = "gci-update-strategy"
+    value = "update_disabled"
+  }
+
+  max_size         = 2
+  min_size         = 0
+  desired_capacity = 0
+
+  whitelist        = ["n1-standard-1"]
+  root_volume_type = "pd-ssd"
+ %v
+}
+
+`
+
+const testBaselineOceanGKEConfig_Update = `
+resource "` + string(commons.OceanGKEResourceName) + `" "%v" {
+  provider = "%v"
+
+  name            = "%v"
+  controller_id   = "terraform-tests-do-not-delete"
+  cluster_name    = "terraform-tests-do-not-delete"
+  master_location = "us-central1-a"
+
+  availability_zones = ["us-central1-a"]
+  subnet_name        = "default"
+  source_image       = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+
+  metadata {
+    key   = "gci-update-strategy"
+    value = "update_disabled"
+  }
+
+  max_size         = 3
+  min_size         = 1
+  desired_capacity = 1
+
+  whitelist        = ["n1-standard-1", "n1-standard-2"]
+  root_volume_type = "pd-standard"
+ %v
+}
+
+`
+
+// endregion
+
+// region Ocean GKE: Autoscaler
+func TestAccSpotinstOceanGKE_Autoscaler(t *testing.T) {
+	clusterName := "terraform-acc-tests-ocean-gke-autoscaler"
+	resourceName := createOceanGKEResourceName(clusterName)
+
+	var cluster gcp.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:    clusterName,
+					fieldsToAppend: testOceanGKEClusterAutoscaler_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_is_auto_config", "false"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_cooldown", "300"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.auto_headroom_percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_headroom.0.cpu_per_unit", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_headroom.0.gpu_per_unit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_headroom.0.memory_per_unit", "512"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_headroom.0.num_of_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_down.0.evaluation_periods", "300"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_down.0.max_scale_down_percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.resource_limits.0.max_vcpu", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.resource_limits.0.max_memory_gib", "20"),
+				),
+			},
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:    clusterName,
+					fieldsToAppend: testOceanGKEClusterAutoscaler_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_cooldown", "600"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_headroom.0.cpu_per_unit", "512"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.autoscale_down.0.max_scale_down_percentage", "50"),
+				),
+			},
+		},
+	})
+}
+
+const testOceanGKEClusterAutoscaler_Create = `
+  autoscaler {
+    autoscale_is_enabled     = true
+    autoscale_is_auto_config = false
+    autoscale_cooldown       = 300
+    auto_headroom_percentage = 10
+
+    autoscale_headroom {
+      cpu_per_unit    = 1024
+      gpu_per_unit    = 1
+      memory_per_unit = 512
+      num_of_units    = 2
+    }
+
+    autoscale_down {
+      evaluation_periods        = 300
+      max_scale_down_percentage = 20
+    }
+
+    resource_limits {
+      max_vcpu       = 1024
+      max_memory_gib = 20
+    }
+  }
+`
+
+const testOceanGKEClusterAutoscaler_Update = `
+  autoscaler {
+    autoscale_is_enabled     = false
+    autoscale_is_auto_config = false
+    autoscale_cooldown       = 600
+
+    autoscale_headroom {
+      cpu_per_unit    = 512
+      gpu_per_unit    = 0
+      memory_per_unit = 256
+      num_of_units    = 1
+    }
+
+    autoscale_down {
+      evaluation_periods        = 300
+      max_scale_down_percentage = 50
+    }
+
+    resource_limits {
+      max_vcpu       = 512
+      max_memory_gib = 10
+    }
+  }
+`
+
+// endregion
+
+// region Ocean GKE: Scheduling
+func TestAccSpotinstOceanGKE_Scheduling(t *testing.T) {
+	clusterName := "terraform-acc-tests-ocean-gke-scheduling"
+	resourceName := createOceanGKEResourceName(clusterName)
+
+	var cluster gcp.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:    clusterName,
+					fieldsToAppend: testOceanGKEClusterScheduling_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:17:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.cron_expression", "0 1 1 * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.task_type", "clusterRoll"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.task_parameters.0.cluster_roll.0.batch_size_percentage", "20"),
+				),
+			},
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:    clusterName,
+					fieldsToAppend: testOceanGKEClusterScheduling_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:18:30"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.cron_expression", "0 1 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.is_enabled", "false"),
+				),
+			},
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName: clusterName,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testOceanGKEClusterScheduling_Create = `
+  scheduled_task {
+    shutdown_hours {
+      is_enabled   = true
+      time_windows = ["Fri:15:30-Sat:17:30"]
+    }
+    tasks {
+      is_enabled      = true
+      cron_expression = "0 1 1 * *"
+      task_type       = "clusterRoll"
+      task_parameters {
+        cluster_roll {
+          batch_size_percentage = 20
+        }
+      }
+    }
+  }
+`
+
+const testOceanGKEClusterScheduling_Update = `
+  scheduled_task {
+    shutdown_hours {
+      is_enabled   = false
+      time_windows = ["Fri:15:30-Sat:18:30"]
+    }
+    tasks {
+      is_enabled      = false
+      cron_expression = "0 1 * * *"
+      task_type       = "clusterRoll"
+      task_parameters {
+        cluster_roll {
+          batch_size_percentage = 20
+        }
+      }
+    }
+  }
+`
+
+// endregion
+
+// region Ocean GKE: Update Policy
+func TestAccSpotinstOceanGKE_UpdatePolicy(t *testing.T) {
+	clusterName := "terraform-acc-tests-ocean-gke-update-policy"
+	resourceName := createOceanGKEResourceName(clusterName)
+
+	var cluster gcp.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:    clusterName,
+					fieldsToAppend: testOceanGKEClusterUpdatePolicy_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "false"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "33"),
+				),
+			},
+			{
+				Config: createOceanGKETerraform(&OceanGKEMetadata{
+					clusterName:          clusterName,
+					updateBaselineFields: true,
+					fieldsToAppend:       testOceanGKEClusterUpdatePolicy_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExists(&cluster, resourceName),
+					testCheckOceanGKEAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.should_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.conditioned_roll", "true"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_size_percentage", "66"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.batch_min_healthy_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "update_policy.0.roll_config.0.respect_pdb", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testOceanGKEClusterUpdatePolicy_Create = `
+  update_policy {
+    should_roll = false
+
+    roll_config {
+      batch_size_percentage = 33
+    }
+  }
+`
+
+const testOceanGKEClusterUpdatePolicy_Update = `
+  update_policy {
+    should_roll      = true
+    conditioned_roll = true
+
+    roll_config {
+      batch_size_percentage        = 66
+      batch_min_healthy_percentage = 50
+      respect_pdb                  = true
+    }
+  }
+`
+
+// endregion
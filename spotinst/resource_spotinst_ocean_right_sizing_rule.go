@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,7 +28,8 @@ func resourceSpotinstOceanRightSizingRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanRightSizingRuleResource.GetSchemaMap(),
+		Schema:   commons.OceanRightSizingRuleResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -76,7 +76,7 @@ func resourceSpotinstOceanRightSizingRuleCreate(ctx context.Context, resourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	rightSizingRuleName, err := createOceanRightSizingRule(resourceData, rightSizingRule, meta.(*Client))
+	rightSizingRuleName, err := createOceanRightSizingRule(ctx, resourceData, rightSizingRule, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -89,16 +89,16 @@ func resourceSpotinstOceanRightSizingRuleCreate(ctx context.Context, resourceDat
 
 }
 
-func createOceanRightSizingRule(resourceData *schema.ResourceData, rsr *right_sizing.RightsizingRule, spotinstClient *Client) (*string, error) {
+func createOceanRightSizingRule(ctx context.Context, resourceData *schema.ResourceData, rsr *right_sizing.RightsizingRule, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(rsr); err != nil {
 		return nil, err
 	} else {
 		log.Printf("===> RightSizing Rule create configuration: %s", json)
 	}
 
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &right_sizing.CreateRightsizingRuleInput{RightsizingRule: rsr}
-		_, err := spotinstClient.ocean.RightSizing().CreateRightsizingRule(context.Background(), input)
+		_, err := spotinstClient.ocean.RightSizing().CreateRightsizingRule(ctx, input)
 		if err != nil {
 
 			// Some other error, report it.
@@ -26,7 +26,6 @@ import (
 
 const (
 	ErrCodeResourceDoesNotExist = "RESOURCE_DOES_NOT_EXIST"
-	deleteTimeout               = 20 * time.Minute
 	sleepBetweenDeleteChecks    = 30 * time.Second
 )
 
@@ -42,7 +41,8 @@ func resourceSpotinstOceanSpark() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.OceanSparkResource.GetSchemaMap(),
+		Schema:   commons.OceanSparkResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -68,7 +68,7 @@ func resourceSpotinstSparkClusterCreate(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
-	clusterID, err := createSparkCluster(cluster, meta.(*Client))
+	clusterID, err := createSparkCluster(ctx, resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -79,7 +79,7 @@ func resourceSpotinstSparkClusterCreate(ctx context.Context, resourceData *schem
 	return resourceSpotinstSparkClusterRead(ctx, resourceData, meta)
 }
 
-func createSparkCluster(cluster *spark.Cluster, spotinstClient *Client) (*string, error) {
+func createSparkCluster(ctx context.Context, resourceData *schema.ResourceData, cluster *spark.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
 	} else {
@@ -92,9 +92,9 @@ func createSparkCluster(cluster *spark.Cluster, spotinstClient *Client) (*string
 	}
 
 	var resp *spark.CreateClusterOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &spark.CreateClusterInput{Cluster: createClusterRequest}
-		r, err := spotinstClient.ocean.Spark().CreateCluster(context.Background(), input)
+		r, err := spotinstClient.ocean.Spark().CreateCluster(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -242,7 +242,7 @@ func deleteSparkCluster(ctx context.Context, resourceData *schema.ResourceData,
 }
 
 func waitUntilClusterDeleted(ctx context.Context, resourceData *schema.ResourceData, oceanSparkClient spark.Service) error {
-	timeout := time.After(deleteTimeout)
+	timeout := time.After(resourceData.Timeout(schema.TimeoutDelete))
 	checkDeleted := time.NewTicker(sleepBetweenDeleteChecks)
 	defer checkDeleted.Stop()
 
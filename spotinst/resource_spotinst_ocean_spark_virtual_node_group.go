@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
@@ -29,7 +28,8 @@ func resourceSpotinstOceanSparkVirtualNodeGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.OceanSparkVirtualNodeGroupResource.GetSchemaMap(),
+		Schema:   commons.OceanSparkVirtualNodeGroupResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -115,7 +115,7 @@ func resourceSpotinstSparkClusterVirtualNodeGroupCreate(ctx context.Context, res
 		return diag.FromErr(err)
 	}
 
-	vngID, err := attachVng(vng, meta.(*Client))
+	vngID, err := attachVng(ctx, resourceData, vng, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -126,7 +126,7 @@ func resourceSpotinstSparkClusterVirtualNodeGroupCreate(ctx context.Context, res
 	return resourceSpotinstSparkClusterVirtualNodeGroupRead(ctx, resourceData, meta)
 }
 
-func attachVng(vng *spark.DedicatedVirtualNodeGroup, spotinstClient *Client) (*string, error) {
+func attachVng(ctx context.Context, resourceData *schema.ResourceData, vng *spark.DedicatedVirtualNodeGroup, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(vng); err != nil {
 		return nil, err
 	} else {
@@ -138,9 +138,9 @@ func attachVng(vng *spark.DedicatedVirtualNodeGroup, spotinstClient *Client) (*s
 	}
 
 	var resp *spark.AttachVngOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &spark.AttachVngInput{ClusterID: vng.OceanSparkClusterID, VirtualNodeGroup: attachRequest}
-		r, err := spotinstClient.ocean.Spark().AttachVirtualNodeGroup(context.Background(), input)
+		r, err := spotinstClient.ocean.Spark().AttachVirtualNodeGroup(ctx, input)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
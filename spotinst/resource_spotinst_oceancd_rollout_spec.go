@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/oceancd_rollout_spec"
@@ -34,7 +33,8 @@ func resourceSpotinstOceanCDRolloutSpec() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanCDRolloutSpecResource.GetSchemaMap(),
+		Schema:   commons.OceanCDRolloutSpecResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -57,7 +57,7 @@ func resourceSpotinstOceanCDRolloutSpecCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	vpname, err := createRolloutSpec(RolloutSpec, meta.(*Client))
+	vpname, err := createRolloutSpec(ctx, resourceData, RolloutSpec, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -69,7 +69,7 @@ func resourceSpotinstOceanCDRolloutSpecCreate(ctx context.Context, resourceData
 	return resourceSpotinstOceanCDRolloutSpecRead(ctx, resourceData, meta)
 }
 
-func createRolloutSpec(RolloutSpec *oceancd.RolloutSpec, spotinstClient *Client) (*string, error) {
+func createRolloutSpec(ctx context.Context, resourceData *schema.ResourceData, RolloutSpec *oceancd.RolloutSpec, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(RolloutSpec); err != nil {
 		return nil, err
 	} else {
@@ -77,9 +77,9 @@ func createRolloutSpec(RolloutSpec *oceancd.RolloutSpec, spotinstClient *Client)
 	}
 
 	var resp *oceancd.CreateRolloutSpecOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &oceancd.CreateRolloutSpecInput{RolloutSpec: RolloutSpec}
-		r, err := spotinstClient.oceancd.CreateRolloutSpec(context.Background(), input)
+		r, err := spotinstClient.oceancd.CreateRolloutSpec(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/oceancd_strategy_canary"
@@ -32,7 +31,8 @@ func resourceSpotinstOceanCDStrategy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanCDStrategyResource.GetSchemaMap(),
+		Schema:   commons.OceanCDStrategyResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -53,7 +53,7 @@ func resourceSpotinstOceanCDStrategyCreate(ctx context.Context, resourceData *sc
 		return diag.FromErr(err)
 	}
 
-	vpname, err := createStrategy(Strategy, meta.(*Client))
+	vpname, err := createStrategy(ctx, resourceData, Strategy, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -65,7 +65,7 @@ func resourceSpotinstOceanCDStrategyCreate(ctx context.Context, resourceData *sc
 	return resourceSpotinstOceanCDStrategyRead(ctx, resourceData, meta)
 }
 
-func createStrategy(Strategy *oceancd.Strategy, spotinstClient *Client) (*string, error) {
+func createStrategy(ctx context.Context, resourceData *schema.ResourceData, Strategy *oceancd.Strategy, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(Strategy); err != nil {
 		return nil, err
 	} else {
@@ -73,9 +73,9 @@ func createStrategy(Strategy *oceancd.Strategy, spotinstClient *Client) (*string
 	}
 
 	var resp *oceancd.CreateStrategyOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &oceancd.CreateStrategyInput{Strategy: Strategy}
-		r, err := spotinstClient.oceancd.CreateStrategy(context.Background(), input)
+		r, err := spotinstClient.oceancd.CreateStrategy(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/oceancd_verification_provider"
@@ -36,7 +35,8 @@ func resourceSpotinstOceanCDVerificationProvider() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanCDVerificationProviderResource.GetSchemaMap(),
+		Schema:   commons.OceanCDVerificationProviderResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -62,7 +62,7 @@ func resourceSpotinstOceanCDVerificationProviderCreate(ctx context.Context, reso
 		return diag.FromErr(err)
 	}
 
-	vpname, err := createVerificationProvider(verificationProvider, meta.(*Client))
+	vpname, err := createVerificationProvider(ctx, resourceData, verificationProvider, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -74,7 +74,7 @@ func resourceSpotinstOceanCDVerificationProviderCreate(ctx context.Context, reso
 	return resourceSpotinstOceanCDVerificationProviderRead(ctx, resourceData, meta)
 }
 
-func createVerificationProvider(verificationProvider *oceancd.VerificationProvider, spotinstClient *Client) (*string, error) {
+func createVerificationProvider(ctx context.Context, resourceData *schema.ResourceData, verificationProvider *oceancd.VerificationProvider, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(verificationProvider); err != nil {
 		return nil, err
 	} else {
@@ -82,9 +82,9 @@ func createVerificationProvider(verificationProvider *oceancd.VerificationProvid
 	}
 
 	var resp *oceancd.CreateVerificationProviderOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &oceancd.CreateVerificationProviderInput{VerificationProvider: verificationProvider}
-		r, err := spotinstClient.oceancd.CreateVerificationProvider(context.Background(), input)
+		r, err := spotinstClient.oceancd.CreateVerificationProvider(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
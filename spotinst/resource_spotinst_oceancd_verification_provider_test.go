@@ -0,0 +1,85 @@
+package spotinst
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testOceanCDVerificationProviderData(t *testing.T, r *schema.Resource) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":        "vp",
+		"cluster_ids": []interface{}{"cluster-1"},
+		"datadog": []interface{}{
+			map[string]interface{}{
+				"address": "https://api.datadoghq.com",
+				"api_key": "dd-api-key",
+				"app_key": "dd-app-key",
+			},
+		},
+	})
+}
+
+func TestOceanCDVerificationProvider_RedactsSecretsFromLogs(t *testing.T) {
+	api := newFakeAPI(t).KeyBy("/ocean/cd/verificationProvider", "name")
+	r := resourceSpotinstOceanCDVerificationProvider()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	resourceData := testOceanCDVerificationProviderData(t, r)
+	if diags := r.CreateContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+
+	logs := buf.String()
+	for _, secret := range []string{"dd-api-key", "dd-app-key", "fake-token"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("logs contain %q", secret)
+		}
+	}
+	if !strings.Contains(logs, "<sensitive>") {
+		t.Errorf("logs do not contain redacted values")
+	}
+
+	if body := api.Requests()[0].Body; !strings.Contains(fmt.Sprint(body), "dd-api-key") {
+		t.Errorf("request body does not contain the api key: %v", body)
+	}
+}
+
+func TestOceanCDVerificationProvider_KeepsMaskedSecrets(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodGet, "/ocean/cd/verificationProvider/vp", map[string]interface{}{
+			"name":       "vp",
+			"clusterIds": []interface{}{"cluster-1"},
+			"datadog": map[string]interface{}{
+				"address": "https://api.datadoghq.com",
+				"apiKey":  "********",
+				"appKey":  "********",
+			},
+		})
+	r := resourceSpotinstOceanCDVerificationProvider()
+
+	resourceData := testOceanCDVerificationProviderData(t, r)
+	resourceData.SetId("vp")
+	if diags := r.ReadContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	for key, want := range map[string]string{
+		"datadog.0.api_key": "dd-api-key",
+		"datadog.0.app_key": "dd-app-key",
+	} {
+		if got := resourceData.Get(key); got != want {
+			t.Errorf("%s: got %q, want %q", key, got, want)
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/oceancd_verification_template"
@@ -33,7 +32,8 @@ func resourceSpotinstOceanCDVerificationTemplate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.OceanCDVerificationTemplateResource.GetSchemaMap(),
+		Schema:   commons.OceanCDVerificationTemplateResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -56,7 +56,7 @@ func resourceSpotinstOceanCDVerificationTemplateCreate(ctx context.Context, reso
 		return diag.FromErr(err)
 	}
 
-	vtname, err := createVerificationTemplate(verificationTemplate, meta.(*Client))
+	vtname, err := createVerificationTemplate(ctx, resourceData, verificationTemplate, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -68,7 +68,7 @@ func resourceSpotinstOceanCDVerificationTemplateCreate(ctx context.Context, reso
 	return resourceSpotinstOceanCDVerificationTemplateRead(ctx, resourceData, meta)
 }
 
-func createVerificationTemplate(VerificationTemplate *oceancd.VerificationTemplate, spotinstClient *Client) (*string, error) {
+func createVerificationTemplate(ctx context.Context, resourceData *schema.ResourceData, VerificationTemplate *oceancd.VerificationTemplate, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(VerificationTemplate); err != nil {
 		return nil, err
 	} else {
@@ -76,9 +76,9 @@ func createVerificationTemplate(VerificationTemplate *oceancd.VerificationTempla
 	}
 
 	var resp *oceancd.CreateVerificationTemplateOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &oceancd.CreateVerificationTemplateInput{VerificationTemplate: VerificationTemplate}
-		r, err := spotinstClient.oceancd.CreateVerificationTemplate(context.Background(), input)
+		r, err := spotinstClient.oceancd.CreateVerificationTemplate(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -23,7 +23,8 @@ func resourceOrgPolicy() *schema.Resource {
 		ReadContext:   resourceOrgPolicyRead,
 		DeleteContext: resourceOrgPolicyDelete,
 
-		Schema: commons.OrgPolicyResource.GetSchemaMap(),
+		Schema:   commons.OrgPolicyResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -4,14 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/spotinst/spotinst-sdk-go/service/organization"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/organization_policy"
 )
 
 func createOrganizationPolicyResourceName(name string) string {
@@ -152,3 +155,111 @@ resource "` + string(commons.OrgPolicyResourceName) + `" "%v" {
 `
 
 // endregion
+
+func TestOrganizationPolicy_ValidateStatements(t *testing.T) {
+	r := resourceOrgPolicy()
+
+	config := func(effect string, actions, resources []interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "policy",
+			"policy_content": []interface{}{
+				map[string]interface{}{
+					"statements": []interface{}{
+						map[string]interface{}{
+							"effect":    effect,
+							"actions":   actions,
+							"resources": resources,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	cases := []struct {
+		name      string
+		effect    string
+		actions   []interface{}
+		resources []interface{}
+		wantError string
+		wantWarn  string
+	}{
+		{name: "known actions", effect: "ALLOW",
+			actions: []interface{}{"ocean:createCluster", "elastigroup:getGroup"}, resources: []interface{}{"o-abcd1234"}},
+		{name: "wildcards", effect: "DENY",
+			actions: []interface{}{"*", "ocean:*", "*:get*", "elastigroup:list*"}, resources: []interface{}{"*", "sig-*"}},
+		{name: "action typo", effect: "ALLOW",
+			actions: []interface{}{"ocean:creatCluster"}, resources: []interface{}{"*"},
+			wantWarn: `action "ocean:creatCluster" is not in the catalogue of known actions, did you mean "ocean:createCluster"?`},
+		{name: "action case", effect: "ALLOW",
+			actions: []interface{}{"ocean:CreateCluster"}, resources: []interface{}{"*"},
+			wantWarn: `did you mean "ocean:createCluster"?`},
+		{name: "service typo", effect: "ALLOW",
+			actions: []interface{}{"ocaen:createCluster"}, resources: []interface{}{"*"},
+			wantWarn: `service "ocaen" of action "ocaen:createCluster" is not in the catalogue of known services, did you mean "ocean"?`},
+		{name: "malformed action", effect: "ALLOW",
+			actions: []interface{}{"createCluster"}, resources: []interface{}{"*"},
+			wantError: "expected the form <service>:<action>"},
+		{name: "invalid effect", effect: "PERMIT",
+			actions: []interface{}{"ocean:createCluster"}, resources: []interface{}{"*"},
+			wantError: "PERMIT"},
+		{name: "action missing from the catalogue", effect: "ALLOW",
+			actions: []interface{}{"ocean:getCluster", "ocean:frobnicate*", "elastigroup:getGroup"}, resources: []interface{}{"*"}},
+		{name: "wildcard service typo", effect: "ALLOW",
+			actions: []interface{}{"ocaen:*"}, resources: []interface{}{"*"},
+			wantWarn: `did you mean "ocean"?`},
+		{name: "unknown resource", effect: "ALLOW",
+			actions: []interface{}{"ocean:createCluster"}, resources: []interface{}{"my-cluster"},
+			wantWarn: "is neither"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var errs, warns []string
+			for _, d := range r.Validate(config(tc.effect, tc.actions, tc.resources)) {
+				if d.Severity == diag.Error {
+					errs = append(errs, d.Summary)
+				} else {
+					warns = append(warns, d.Summary)
+				}
+			}
+
+			if tc.wantError == "" && len(errs) > 0 {
+				t.Errorf("unexpected errors: %v", errs)
+			}
+			if tc.wantError != "" && !strings.Contains(strings.Join(errs, "\n"), tc.wantError) {
+				t.Errorf("errors %v do not contain %q", errs, tc.wantError)
+			}
+			if tc.wantWarn == "" && len(warns) > 0 {
+				t.Errorf("unexpected warnings: %v", warns)
+			}
+			if tc.wantWarn != "" && !strings.Contains(strings.Join(warns, "\n"), tc.wantWarn) {
+				t.Errorf("warnings %v do not contain %q", warns, tc.wantWarn)
+			}
+		})
+	}
+}
+
+// The actions and IDs below are taken from the documents the catalogue cites
+// as its sources, and must remain known.
+func TestOrganizationPolicy_DocumentedCatalogue(t *testing.T) {
+	for _, action := range []string{"ocean:createCluster", "ocean:deleteCluster", "ocean:updateCluster"} {
+		if warnings, errs := organization_policy.ValidateAction(action, "actions"); len(warnings) > 0 || len(errs) > 0 {
+			t.Errorf("%s: got warnings %v and errors %v, want none", action, warnings, errs)
+		}
+
+		// A typo is only caught when the action is in the catalogue.
+		typo := strings.Replace(action, "Cluster", "Clustr", 1)
+		warnings, _ := organization_policy.ValidateAction(typo, "actions")
+		if want := fmt.Sprintf("did you mean %q?", action); len(warnings) != 1 || !strings.HasSuffix(warnings[0], want) {
+			t.Errorf("%s: got warnings %v, want one ending with %q", typo, warnings, want)
+		}
+	}
+
+	for _, id := range []string{"act-123456", "sig-12345678", "o-123456", "ols-1a2b576", "vng-12345", "ssn-01234567",
+		"smi-12345", "di-123456", "pol-vv7d8c06", "ugr-abcd1234", "u-372gf6ae", "pu-abcd1234"} {
+		if warnings, errs := organization_policy.ValidateResource(id, "resources"); len(warnings) > 0 || len(errs) > 0 {
+			t.Errorf("%s: got warnings %v and errors %v, want none", id, warnings, errs)
+		}
+	}
+}
@@ -23,7 +23,8 @@ func resourceOrgProgrammaticUser() *schema.Resource {
 		ReadContext:   resourceOrgProgrammaticUserRead,
 		DeleteContext: resourceOrgProgrammaticUserDelete,
 
-		Schema: commons.OrgProgrammaticUserResource.GetSchemaMap(),
+		Schema:   commons.OrgProgrammaticUserResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -92,10 +93,11 @@ func resourceOrgProgrammaticUserCreate(ctx context.Context, resourceData *schema
 		return diag.FromErr(err)
 	}
 
-	userId, err := createProgrammaticUser(programmaticUser, meta.(*Client))
+	createdUser, err := createProgrammaticUser(programmaticUser, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	userId := createdUser.ProgUserId
 
 	var updateErr error = nil
 
@@ -110,16 +112,21 @@ func resourceOrgProgrammaticUserCreate(ctx context.Context, resourceData *schema
 	resourceData.SetId(spotinst.StringValue(userId))
 	log.Printf("===> User created successfully: %s <===", resourceData.Id())
 
+	// The token is only returned on creation, and cannot be read afterwards.
+	if err := resourceData.Set(string(organizationPackage.Token), spotinst.StringValue(createdUser.Token)); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(organizationPackage.Token), err)
+	}
+
 	return resourceOrgProgrammaticUserRead(ctx, resourceData, meta)
 }
 
-func createProgrammaticUser(userObj *organization.ProgrammaticUser, spotinstClient *Client) (*string, error) {
+func createProgrammaticUser(userObj *organization.ProgrammaticUser, spotinstClient *Client) (*organization.ProgrammaticUser, error) {
 	input := userObj
 	resp, err := spotinstClient.organization.CreateProgUser(context.Background(), input)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] failed to create user: %s", err)
 	}
-	return resp.ProgrammaticUser.ProgUserId, nil
+	return resp.ProgrammaticUser, nil
 }
 
 func resourceOrgProgrammaticUserUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,31 @@
+package spotinst
+
+import (
+	orgAttachment "github.com/spotinst/terraform-provider-spotinst/spotinst/organization_attachment"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// orgProgrammaticUserPolicyAttachment manages a single policy attached to a
+// programmatic user.
+var orgProgrammaticUserPolicyAttachment = &orgAttachmentKind{
+	resource: func() *commons.OrgAttachmentTerraformResource {
+		return commons.OrgProgrammaticUserPolicyAttachmentResource
+	},
+	read:  readOrgProgrammaticUserPolicies,
+	write: writeOrgUserPolicies,
+}
+
+func resourceOrgProgrammaticUserPolicyAttachment() *schema.Resource {
+	setupOrgProgrammaticUserPolicyAttachment()
+	return orgProgrammaticUserPolicyAttachment.schemaResource()
+}
+
+func setupOrgProgrammaticUserPolicyAttachment() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	orgAttachment.SetupProgrammaticUserPolicyAttachment(fieldsMap)
+
+	commons.OrgProgrammaticUserPolicyAttachmentResource = commons.NewOrgAttachmentResource(commons.OrgProgrammaticUserPolicyAttachmentResourceName, fieldsMap)
+}
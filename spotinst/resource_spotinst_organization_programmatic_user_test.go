@@ -1,14 +1,19 @@
 package spotinst
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/spotinst/spotinst-sdk-go/service/organization"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -216,3 +221,38 @@ resource "` + string(commons.OrgProgrammaticUserResourceName) + `" "%v" {
 
 }
 `
+
+func TestOrganizationProgrammaticUser_KeepsCreatedToken(t *testing.T) {
+	api := newFakeAPI(t).
+		Respond(http.MethodPost, "/setup/user/programmatic", map[string]interface{}{
+			"id":    "pu-12345678",
+			"name":  "ci",
+			"token": "prog-user-token",
+		}).
+		Respond(http.MethodGet, "/setup/user/pu-12345678", map[string]interface{}{
+			"id":   "pu-12345678",
+			"name": "ci",
+		})
+	r := resourceOrgProgrammaticUser()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "ci",
+	})
+	if diags := r.CreateContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+	if diags := r.ReadContext(context.Background(), resourceData, api.Client()); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	if got := resourceData.Get("token"); got != "prog-user-token" {
+		t.Errorf("token: got %q, want %q", got, "prog-user-token")
+	}
+	if strings.Contains(buf.String(), "prog-user-token") {
+		t.Errorf("logs contain the token")
+	}
+}
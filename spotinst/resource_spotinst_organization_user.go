@@ -21,7 +21,8 @@ func resourceOrgUser() *schema.Resource {
 		ReadContext:   resourceOrgUserRead,
 		DeleteContext: resourceOrgUserDelete,
 
-		Schema: commons.OrgUserResource.GetSchemaMap(),
+		Schema:   commons.OrgUserResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -137,11 +138,16 @@ func updatePolicyMapping(policies []*organization.UserPolicy, userId *string, sp
 	return nil
 }
 
+// updateUserGroupMapping replaces the user groups of a user. The request is
+// sent directly, since the SDK omits an empty list, which clears the user
+// groups.
 func updateUserGroupMapping(userGroupIds []string, userId *string, spotinstClient *Client) error {
-	err := spotinstClient.organization.UpdateUserGroupMappingOfUser(context.Background(), &organization.UpdateUserGroupMappingOfUserInput{
-		UserID:       userId,
-		UserGroupIds: userGroupIds,
-	})
+	if userGroupIds == nil {
+		userGroupIds = []string{}
+	}
+	err := putOrgMappings(context.Background(), spotinstClient,
+		fmt.Sprintf("/setup/user/%s/userGroupMapping", spotinst.StringValue(userId)),
+		map[string]interface{}{"userGroupIds": userGroupIds})
 	if err != nil {
 		return fmt.Errorf("[ERROR] failed to update policy mapping for user: %s", err)
 	}
@@ -23,7 +23,8 @@ func resourceOrgUserGroup() *schema.Resource {
 		ReadContext:   resourceOrgUserGroupRead,
 		DeleteContext: resourceOrgUserGroupDelete,
 
-		Schema: commons.OrgUserGroupResource.GetSchemaMap(),
+		Schema:   commons.OrgUserGroupResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -154,7 +155,9 @@ func resourceOrgUserGroupUpdate(ctx context.Context, resourceData *schema.Resour
 
 		if userGroup.UserIds != nil {
 			userIds := userGroup.UserIds
-			updateUserIdsMapping(userIds, &id, meta.(*Client))
+			if err := updateUserIdsMapping(userIds, &id, meta.(*Client)); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
 		userGroup.UserIds = nil
@@ -182,11 +185,15 @@ func updatePolicyMappingOfUserGroup(policies []*organization.UserPolicy, userGro
 	return nil
 }
 
+// updateUserIdsMapping replaces the users of a user group. The request is
+// sent directly, since the SDK omits an empty list, which clears the users.
 func updateUserIdsMapping(userIds []string, userGroupId *string, spotinstClient *Client) error {
-	err := spotinstClient.organization.UpdateUserMappingOfUserGroup(context.Background(), &organization.UpdateUserMappingOfUserGroupInput{
-		UserGroupId: userGroupId,
-		UserIds:     userIds,
-	})
+	if userIds == nil {
+		userIds = []string{}
+	}
+	err := putOrgMappings(context.Background(), spotinstClient,
+		fmt.Sprintf("/setup/access/userGroup/%s/userMapping", spotinst.StringValue(userGroupId)),
+		map[string]interface{}{"userIds": userIds})
 	if err != nil {
 		return fmt.Errorf("[ERROR] failed to update policy mapping for user: %s", err)
 	}
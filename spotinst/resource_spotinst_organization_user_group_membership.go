@@ -0,0 +1,28 @@
+package spotinst
+
+import (
+	orgAttachment "github.com/spotinst/terraform-provider-spotinst/spotinst/organization_attachment"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// orgUserGroupMembership manages the membership of a single user in a user group.
+var orgUserGroupMembership = &orgAttachmentKind{
+	resource: func() *commons.OrgAttachmentTerraformResource { return commons.OrgUserGroupMembershipResource },
+	read:     readOrgUserGroupUsers,
+	write:    writeOrgUserGroupUsers,
+}
+
+func resourceOrgUserGroupMembership() *schema.Resource {
+	setupOrgUserGroupMembership()
+	return orgUserGroupMembership.schemaResource()
+}
+
+func setupOrgUserGroupMembership() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	orgAttachment.SetupUserGroupMembership(fieldsMap)
+
+	commons.OrgUserGroupMembershipResource = commons.NewOrgAttachmentResource(commons.OrgUserGroupMembershipResourceName, fieldsMap)
+}
@@ -0,0 +1,28 @@
+package spotinst
+
+import (
+	orgAttachment "github.com/spotinst/terraform-provider-spotinst/spotinst/organization_attachment"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// orgUserGroupPolicyAttachment manages a single policy attached to a user group.
+var orgUserGroupPolicyAttachment = &orgAttachmentKind{
+	resource: func() *commons.OrgAttachmentTerraformResource { return commons.OrgUserGroupPolicyAttachmentResource },
+	read:     readOrgUserGroupPolicies,
+	write:    writeOrgUserGroupPolicies,
+}
+
+func resourceOrgUserGroupPolicyAttachment() *schema.Resource {
+	setupOrgUserGroupPolicyAttachment()
+	return orgUserGroupPolicyAttachment.schemaResource()
+}
+
+func setupOrgUserGroupPolicyAttachment() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	orgAttachment.SetupUserGroupPolicyAttachment(fieldsMap)
+
+	commons.OrgUserGroupPolicyAttachmentResource = commons.NewOrgAttachmentResource(commons.OrgUserGroupPolicyAttachmentResourceName, fieldsMap)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 
 	"github.com/spotinst/spotinst-sdk-go/service/organization"
@@ -188,3 +189,55 @@ resource "` + string(commons.OrgUserGroupResourceName) + `" "%v" {
 `
 
 // endregion
+
+func TestOrganizationUserGroup_EmptyUserIdsClearsUsers(t *testing.T) {
+	api := newFakeAPI(t).
+		Put("/setup/access/userGroup/ugr-12345678", map[string]interface{}{
+			"id":      "ugr-12345678",
+			"name":    "devs",
+			"userIds": []interface{}{"u-12345678"},
+		}).
+		Mapping("userMapping")
+	r := resourceOrgUserGroup()
+	client := api.Client()
+
+	state := &terraform.InstanceState{
+		ID: "ugr-12345678",
+		Attributes: map[string]string{
+			"id":         "ugr-12345678",
+			"name":       "devs",
+			"user_ids.#": "1",
+			"user_ids.0": "u-12345678",
+		},
+	}
+
+	// Omitting user_ids leaves the users untouched.
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "devs",
+	})
+	diff, err := r.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if diff != nil && diff.Attributes["user_ids.#"] != nil {
+		t.Fatalf("diff without user_ids: got %v, want none", diff.Attributes["user_ids.#"])
+	}
+
+	// An empty list clears them.
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "devs",
+		"user_ids": []interface{}{},
+	})
+	diff, err = r.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("apply failed: %v", diags)
+	}
+
+	group, _ := api.Object("/setup/access/userGroup/ugr-12345678")
+	if got := group["userIds"]; !reflect.DeepEqual(got, []interface{}{}) {
+		t.Errorf("userIds after update: got %v, want []", got)
+	}
+}
@@ -0,0 +1,28 @@
+package spotinst
+
+import (
+	orgAttachment "github.com/spotinst/terraform-provider-spotinst/spotinst/organization_attachment"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// orgUserPolicyAttachment manages a single policy attached to a user.
+var orgUserPolicyAttachment = &orgAttachmentKind{
+	resource: func() *commons.OrgAttachmentTerraformResource { return commons.OrgUserPolicyAttachmentResource },
+	read:     readOrgUserPolicies,
+	write:    writeOrgUserPolicies,
+}
+
+func resourceOrgUserPolicyAttachment() *schema.Resource {
+	setupOrgUserPolicyAttachment()
+	return orgUserPolicyAttachment.schemaResource()
+}
+
+func setupOrgUserPolicyAttachment() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	orgAttachment.SetupUserPolicyAttachment(fieldsMap)
+
+	commons.OrgUserPolicyAttachmentResource = commons.NewOrgAttachmentResource(commons.OrgUserPolicyAttachmentResourceName, fieldsMap)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 
 	"github.com/sethvargo/go-password/password"
@@ -211,3 +212,47 @@ resource "` + string(commons.OrgUserResourceName) + `" "%v" {
 `
 
 // endregion
+
+func TestOrganizationUser_EmptyUserGroupIdsClearsUserGroups(t *testing.T) {
+	api := newFakeAPI(t).
+		Put("/setup/user/u-12345678", map[string]interface{}{
+			"userId":       "u-12345678",
+			"email":        "jane@example.com",
+			"firstName":    "Jane",
+			"lastName":     "Doe",
+			"userGroupIds": []interface{}{"ugr-12345678"},
+		}).
+		Mapping("userGroupMapping")
+	r := resourceOrgUser()
+	client := api.Client()
+
+	state := &terraform.InstanceState{
+		ID: "u-12345678",
+		Attributes: map[string]string{
+			"id":               "u-12345678",
+			"email":            "jane@example.com",
+			"first_name":       "Jane",
+			"last_name":        "Doe",
+			"user_group_ids.#": "1",
+			"user_group_ids.0": "ugr-12345678",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"email":          "jane@example.com",
+		"first_name":     "Jane",
+		"last_name":      "Doe",
+		"user_group_ids": []interface{}{},
+	})
+	diff, err := r.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("apply failed: %v", diags)
+	}
+
+	user, _ := api.Object("/setup/user/u-12345678")
+	if got := user["userGroupIds"]; !reflect.DeepEqual(got, []interface{}{}) {
+		t.Errorf("userGroupIds after update: got %v, want []", got)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/azure_v3/stateful_node_azure"
@@ -42,7 +41,8 @@ func resourceSpotinstStatefulNodeAzureV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: commons.StatefulNodeAzureV3Resource.GetSchemaMap(),
+		Schema:   commons.StatefulNodeAzureV3Resource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
@@ -81,7 +81,7 @@ func resourceSpotinstStatefulNodeAzureV3Create(ctx context.Context, resourceData
 			return diag.Errorf("stateful node/azure: failed expanding import vm configuration: %v", err)
 		}
 
-		statefulNodeId, err := createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput, meta.(*Client))
+		statefulNodeId, err := createAzureV3StatefulNodeImportVM(ctx, resourceData, importVMStatefulNodeInput, meta.(*Client))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -90,7 +90,7 @@ func resourceSpotinstStatefulNodeAzureV3Create(ctx context.Context, resourceData
 		log.Printf("===> Stateful node using import vm created successfully: %s <===", resourceData.Id())
 
 	} else {
-		statefulNodeId, err := createAzureV3StatefulNode(statefulNode, meta.(*Client))
+		statefulNodeId, err := createAzureV3StatefulNode(ctx, resourceData, statefulNode, meta.(*Client))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -133,7 +133,7 @@ func expandStatefulNodeAzureImportVMConfig(data interface{}, statefulNode *azure
 	return spec, nil
 }
 
-func createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput *azure.ImportVMStatefulNodeInput, spotinstClient *Client) (*string, error) {
+func createAzureV3StatefulNodeImportVM(ctx context.Context, resourceData *schema.ResourceData, importVMStatefulNodeInput *azure.ImportVMStatefulNodeInput, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(importVMStatefulNodeInput); err != nil {
 		return nil, err
 	} else {
@@ -141,8 +141,8 @@ func createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput *azure.ImportVM
 	}
 
 	var resp *azure.ImportVMStatefulNodeOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
-		r, err := spotinstClient.statefulNode.CloudProviderAzure().ImportVM(context.Background(), importVMStatefulNodeInput)
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		r, err := spotinstClient.statefulNode.CloudProviderAzure().ImportVM(ctx, importVMStatefulNodeInput)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
@@ -158,7 +158,7 @@ func createAzureV3StatefulNodeImportVM(importVMStatefulNodeInput *azure.ImportVM
 	return resp.StatefulNodeImport.StatefulNode.ID, nil
 }
 
-func createAzureV3StatefulNode(statefulNode *azure.StatefulNode, spotinstClient *Client) (*string, error) {
+func createAzureV3StatefulNode(ctx context.Context, resourceData *schema.ResourceData, statefulNode *azure.StatefulNode, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(statefulNode); err != nil {
 		return nil, err
 	} else {
@@ -166,9 +166,9 @@ func createAzureV3StatefulNode(statefulNode *azure.StatefulNode, spotinstClient
 	}
 
 	var resp *azure.CreateStatefulNodeOutput = nil
-	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		input := &azure.CreateStatefulNodeInput{StatefulNode: statefulNode}
-		r, err := spotinstClient.statefulNode.CloudProviderAzure().Create(context.Background(), input)
+		r, err := spotinstClient.statefulNode.CloudProviderAzure().Create(ctx, input)
 		if err != nil {
 			log.Printf("error: %v", err)
 			// Some other error, report it.
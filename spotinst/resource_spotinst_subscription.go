@@ -23,7 +23,8 @@ func resourceSpotinstSubscription() *schema.Resource {
 		ReadContext:   resourceSpotinstSubscriptionRead,
 		DeleteContext: resourceSpotinstSubscriptionDelete,
 
-		Schema: commons.SubscriptionResource.GetSchemaMap(),
+		Schema:   commons.SubscriptionResource.GetSchemaMap(),
+		Timeouts: commons.DefaultResourceTimeouts(),
 	}
 }
 
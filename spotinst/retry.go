@@ -0,0 +1,167 @@
+package spotinst
+
+import (
+	"io"
+	"io/ioutil"
+	stdlog "log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a throttled or failed
+	// request to the Spotinst API is retried.
+	DefaultMaxRetries = 5
+
+	// DefaultMaxRetryBackoff is the default upper bound of the delay between
+	// two attempts of a request.
+	DefaultMaxRetryBackoff = 30 * time.Second
+
+	// minRetryBackoff is the delay before the first retry of a request, which
+	// is doubled on every further attempt.
+	minRetryBackoff = time.Second
+)
+
+// retryTransport retries requests that are throttled by the Spotinst API or
+// fail with a transient server error, waiting between attempts with an
+// exponential backoff and jitter, or as long as the API asks to.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxBackoff time.Duration) *retryTransport {
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxRetryBackoff
+	}
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		minBackoff: minRetryBackoff,
+		maxBackoff: maxBackoff,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(r)
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			// The body has been consumed and cannot be sent again.
+			return resp, err
+		}
+
+		delay := t.backoff(attempt, resp)
+		if err != nil {
+			stdlog.Printf("[WARN] Request %s %s failed: %v, retrying in %s (%d/%d)",
+				req.Method, req.URL.Path, err, delay, attempt+1, t.maxRetries)
+		} else {
+			stdlog.Printf("[WARN] Request %s %s failed with status %d, retrying in %s (%d/%d)",
+				req.Method, req.URL.Path, resp.StatusCode, delay, attempt+1, t.maxRetries)
+
+			// Drain the body to reuse the connection.
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the delay before the next attempt of a request. The delay
+// requested by the API in a Retry-After header takes precedence over the
+// exponential backoff, and both are bounded by the maximum backoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if delay > t.maxBackoff {
+				delay = t.maxBackoff
+			}
+			return delay
+		}
+	}
+
+	delay := t.maxBackoff
+	if attempt < 32 {
+		if d := t.minBackoff << uint(attempt); d > 0 && d < t.maxBackoff {
+			delay = d
+		}
+	}
+
+	// Equal jitter, to spread the retries of concurrent requests.
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// shouldRetry reports whether a request failed in a way that is worth
+// retrying. Throttling is always retried, as the API rejected the request
+// without processing it. Other transient failures, including 503 responses
+// of gateways, may happen after the API acted on the request, so they are
+// only retried for requests that can safely be sent more than once. PUT
+// requests are not among them, as the API uses them for actions such as
+// scaling, detaching instances or starting a roll.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		return isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
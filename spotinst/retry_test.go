@@ -0,0 +1,192 @@
+package spotinst
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	cases := map[string]struct {
+		method   string
+		statuses []int
+		want     int
+		attempts int
+	}{
+		"throttled": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			want:     http.StatusOK,
+			attempts: 3,
+		},
+		"throttled scale": {
+			method:   http.MethodPut,
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			want:     http.StatusOK,
+			attempts: 2,
+		},
+		"unavailable": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:     http.StatusOK,
+			attempts: 2,
+		},
+		"server error of idempotent request": {
+			method:   http.MethodDelete,
+			statuses: []int{http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusOK},
+			want:     http.StatusOK,
+			attempts: 3,
+		},
+		"server error of create": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusBadGateway, http.StatusOK},
+			want:     http.StatusBadGateway,
+			attempts: 1,
+		},
+		"unavailable create": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:     http.StatusServiceUnavailable,
+			attempts: 1,
+		},
+		"server error of scale": {
+			method:   http.MethodPut,
+			statuses: []int{http.StatusInternalServerError, http.StatusOK},
+			want:     http.StatusInternalServerError,
+			attempts: 1,
+		},
+		"unavailable scale": {
+			method:   http.MethodPut,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:     http.StatusServiceUnavailable,
+			attempts: 1,
+		},
+		"client error": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusBadRequest, http.StatusOK},
+			want:     http.StatusBadRequest,
+			attempts: 1,
+		},
+		"retries exhausted": {
+			method:   http.MethodGet,
+			statuses: []int{503, 503, 503, 503, 503},
+			want:     http.StatusServiceUnavailable,
+			attempts: 4,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var attempts int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				if string(body) != `{"group":{}}` {
+					t.Errorf("attempt %d: got body %q", attempts+1, body)
+				}
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tc.statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: testRetryTransport(3)}
+			req, _ := http.NewRequest(tc.method, server.URL, bytes.NewBufferString(`{"group":{}}`))
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.want {
+				t.Errorf("status: got %d, want %d", resp.StatusCode, tc.want)
+			}
+			if attempts != tc.attempts {
+				t.Errorf("attempts: got %d, want %d", attempts, tc.attempts)
+			}
+		})
+	}
+}
+
+type failingTransport struct{ attempts int }
+
+func (t *failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	t.attempts++
+	return nil, errors.New("connection reset by peer")
+}
+
+func TestRetryTransport_NetworkError(t *testing.T) {
+	cases := map[string]int{
+		http.MethodGet:  4,
+		http.MethodPut:  1,
+		http.MethodPost: 1,
+	}
+
+	for method, want := range cases {
+		next := &failingTransport{}
+		transport := testRetryTransport(3)
+		transport.next = next
+
+		req, _ := http.NewRequest(method, "http://localhost/aws/ec2/group/sig-12345678/scale/up", nil)
+		if _, err := (&http.Client{Transport: transport}).Do(req); err == nil {
+			t.Fatalf("%s: expected an error", method)
+		}
+		if next.attempts != want {
+			t.Errorf("%s: attempts: got %d, want %d", method, next.attempts, want)
+		}
+	}
+}
+
+func TestRetryTransport_Canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	transport := testRetryTransport(3)
+	transport.maxBackoff = time.Minute
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	start := time.Now()
+	if _, err := (&http.Client{Transport: transport}).Do(req); err == nil {
+		t.Fatalf("expected the request to be canceled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("canceled request kept waiting for %s", elapsed)
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 10, 8*time.Second)
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second} {
+		if delay := transport.backoff(attempt, nil); delay < max/2 || delay > max {
+			t.Errorf("attempt %d: got delay %s, want between %s and %s", attempt, delay, max/2, max)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if delay := transport.backoff(0, resp); delay != 3*time.Second {
+		t.Errorf("Retry-After: got delay %s, want %s", delay, 3*time.Second)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if delay := transport.backoff(0, resp); delay != 8*time.Second {
+		t.Errorf("Retry-After above the maximum: got delay %s, want %s", delay, 8*time.Second)
+	}
+}
+
+func testRetryTransport(maxRetries int) *retryTransport {
+	transport := newRetryTransport(http.DefaultTransport, maxRetries, time.Millisecond)
+	transport.minBackoff = time.Millisecond
+	return transport
+}